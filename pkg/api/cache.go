@@ -0,0 +1,79 @@
+package api
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ResponseCache holds the responses of GET requests for a limited time. It
+// is safe for concurrent use.
+type ResponseCache struct {
+	TTL     time.Duration // How long responses are kept
+	entries sync.Map      // Request URL to *cacheEntry
+}
+
+// cacheEntry is a cached response
+type cacheEntry struct {
+	body    []byte
+	header  http.Header
+	path    string // API path of the request, used for invalidation
+	expires time.Time
+}
+
+// DefaultResponseCache is shared by the clients created by NewClient, so
+// repeated reads within one command are answered without a request
+var DefaultResponseCache = &ResponseCache{TTL: 60 * time.Second}
+
+// NoCache makes clients created by NewClient send every request instead of
+// using DefaultResponseCache
+var NoCache bool
+
+// Get returns the cached response for a request URL, if it has not expired
+func (rc *ResponseCache) Get(rawURL string) ([]byte, http.Header, bool) {
+	value, ok := rc.entries.Load(rawURL)
+	if !ok {
+		return nil, nil, false
+	}
+	entry := value.(*cacheEntry)
+	if time.Now().After(entry.expires) {
+		rc.entries.Delete(rawURL)
+		return nil, nil, false
+	}
+	return entry.body, entry.header, true
+}
+
+// Set caches the response to a request for the TTL
+func (rc *ResponseCache) Set(rawURL string, body []byte, header http.Header) {
+	path := rawURL
+	if u, err := url.Parse(rawURL); err == nil {
+		path = u.Path
+	}
+	rc.entries.Store(rawURL, &cacheEntry{
+		body:    body,
+		header:  header,
+		path:    path,
+		expires: time.Now().Add(rc.TTL),
+	})
+}
+
+// Invalidate drops the cached responses of the resource a write request
+// changed, such as everything under /courses/5 for a change to
+// /courses/5/assignments/9. Writes outside a resource clear the whole cache.
+func (rc *ResponseCache) Invalidate(path string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	prefix := ""
+	if len(segments) >= 2 {
+		prefix = "/" + segments[0] + "/" + segments[1]
+	}
+
+	rc.entries.Range(func(key, value any) bool {
+		entryPath := value.(*cacheEntry).path
+		if prefix == "" || strings.Contains(entryPath, prefix+"/") || strings.HasSuffix(entryPath, prefix) {
+			rc.entries.Delete(key)
+		}
+		return true
+	})
+}
@@ -2,12 +2,19 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"net/http"
 	"net/url"
+	"os"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Reisender/canvas-cli-v2/pkg/config"
@@ -18,21 +25,94 @@ type Client struct {
 	BaseURL    string
 	APIKey     string
 	HTTPClient *http.Client
+	MaxPages   int // Limit on pages fetched by RequestAllPages, 0 for the default
+
+	// MaxRetries is how many times rate limited (429) and server error (5xx)
+	// responses are retried, and RetryMaxWait caps the wait between retries
+	MaxRetries   int
+	RetryMaxWait time.Duration
+
+	// Cache holds GET responses, nil to send every request
+	Cache *ResponseCache
+
+	ctx context.Context // Context for requests made by the client's methods, see WithContext
 }
 
+const (
+	// defaultMaxPages is the RequestAllPages page limit when MaxPages is not set
+	defaultMaxPages = 100
+
+	// initialRetryDelay is the first backoff delay for retries without Retry-After
+	initialRetryDelay = 500 * time.Millisecond
+)
+
 // NewClient creates a new Canvas API client
 func NewClient() *Client {
 	cfg := config.GetConfig()
 
+	// Use the configured proxy, or HTTP_PROXY and HTTPS_PROXY when none is set.
+	// An invalid proxy fails every request rather than bypassing the proxy.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	if cfg.ProxyURL != "" {
+		proxy, err := config.ParseProxyURL(cfg.ProxyURL)
+		if err != nil {
+			transport.Proxy = func(*http.Request) (*url.URL, error) { return nil, err }
+		} else {
+			transport.Proxy = http.ProxyURL(proxy)
+		}
+	}
+
+	httpClient := &http.Client{Transport: transport}
+	if DebugLevel > 0 {
+		httpClient.Transport = &DebugTransport{Base: httpClient.Transport, Out: os.Stderr, Level: DebugLevel}
+	}
+	if DryRun {
+		// Wrap the debug transport so only the requests actually sent are logged
+		httpClient.Transport = &DryRunTransport{Base: httpClient.Transport, Out: os.Stderr}
+	}
+
+	var cache *ResponseCache
+	if !NoCache && cfg.CacheTTL > 0 {
+		cache = DefaultResponseCache
+		cache.TTL = time.Duration(cfg.CacheTTL) * time.Second
+	}
+
 	return &Client{
-		BaseURL:    cfg.BaseURL,
-		APIKey:     cfg.APIKey,
-		HTTPClient: &http.Client{},
+		BaseURL:      cfg.BaseURL,
+		APIKey:       cfg.APIKey,
+		HTTPClient:   httpClient,
+		Cache:        cache,
+		MaxPages:     cfg.MaxPages,
+		MaxRetries:   3,
+		RetryMaxWait: 30 * time.Second,
+	}
+}
+
+// WithContext returns a copy of the client whose methods make their requests
+// with ctx, so they are cancelled when ctx is done
+func (c *Client) WithContext(ctx context.Context) *Client {
+	client := *c
+	client.ctx = ctx
+	return &client
+}
+
+// context returns the context requests are made with
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
 	}
+	return c.ctx
+}
+
+// WebURL returns the address of a page on the Canvas website, such as
+// "/courses/1", by dropping the API root from the base URL
+func (c *Client) WebURL(path string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(c.BaseURL, "/"), "/api/v1") + path
 }
 
 // Request makes an API request to Canvas
-func (c *Client) Request(method, path string, query url.Values) ([]byte, error) {
+func (c *Client) Request(ctx context.Context, method, path string, query url.Values) ([]byte, error) {
 	// Build the URL
 	endpoint, err := url.Parse(c.BaseURL)
 	if err != nil {
@@ -45,39 +125,86 @@ func (c *Client) Request(method, path string, query url.Values) ([]byte, error)
 		endpoint.RawQuery = query.Encode()
 	}
 
-	// Create the request
-	req, err := http.NewRequest(method, endpoint.String(), nil)
+	body, _, err := c.send(ctx, method, endpoint.String(), path, nil)
+	return body, err
+}
+
+// RequestAllPages makes a GET-style API request and follows the Link header's
+// rel="next" URLs, returning the JSON arrays of every page joined into one.
+// Pages are requested with at most 100 items each, and at most MaxPages pages
+// are fetched.
+func (c *Client) RequestAllPages(ctx context.Context, method, path string, query url.Values) ([]byte, error) {
+	// Build the URL for the first page
+	endpoint, err := url.Parse(c.BaseURL)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, fmt.Errorf("invalid base URL: %w", err)
 	}
 
-	// Add auth header
-	req.Header.Add("Authorization", "Bearer "+c.APIKey)
+	endpoint.Path += path
 
-	// Send the request
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("error sending request: %w", err)
+	pageQuery := url.Values{}
+	for key, values := range query {
+		pageQuery[key] = values
 	}
-	defer resp.Body.Close()
+	if perPage, err := strconv.Atoi(pageQuery.Get("per_page")); err != nil || perPage <= 0 || perPage > 100 {
+		pageQuery.Set("per_page", "100")
+	}
+	endpoint.RawQuery = pageQuery.Encode()
 
-	// Check for errors
-	if resp.StatusCode >= 400 {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(body))
+	maxPages := c.MaxPages
+	if maxPages <= 0 {
+		maxPages = defaultMaxPages
 	}
 
-	// Read the response
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error reading response: %w", err)
+	items := []json.RawMessage{}
+	next := endpoint.String()
+	for page := 1; next != ""; page++ {
+		if page > maxPages {
+			return nil, fmt.Errorf("more than %d pages of results for %s", maxPages, path)
+		}
+
+		body, header, err := c.send(ctx, method, next, path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageItems []json.RawMessage
+		if err := json.Unmarshal(body, &pageItems); err != nil {
+			return nil, fmt.Errorf("error parsing page %d: %w", page, err)
+		}
+		items = append(items, pageItems...)
+
+		next = nextPageURL(header.Get("Link"))
 	}
 
-	return body, nil
+	return json.Marshal(items)
+}
+
+// nextPageURL returns the rel="next" URL from an RFC 5988 Link header, or ""
+// when there is no next page
+func nextPageURL(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		sections := strings.Split(part, ";")
+		if len(sections) < 2 {
+			continue
+		}
+
+		target := strings.TrimSpace(sections[0])
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+
+		for _, param := range sections[1:] {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(target, "<>")
+			}
+		}
+	}
+	return ""
 }
 
 // RequestWithBody makes an API request with a JSON body
-func (c *Client) RequestWithBody(method, path string, query url.Values, body interface{}) ([]byte, error) {
+func (c *Client) RequestWithBody(ctx context.Context, method, path string, query url.Values, body interface{}) ([]byte, error) {
 	// Build the URL
 	endpoint, err := url.Parse(c.BaseURL)
 	if err != nil {
@@ -96,271 +223,1871 @@ func (c *Client) RequestWithBody(method, path string, query url.Values, body int
 		return nil, fmt.Errorf("error marshaling request body: %w", err)
 	}
 
+	responseBody, _, err := c.send(ctx, method, endpoint.String(), path, jsonBody)
+	return responseBody, err
+}
+
+// send makes a request to a full URL, retrying rate limited (429) and server
+// error (5xx) responses up to MaxRetries times. A non-nil body is sent as
+// JSON. path is the API path recorded in the request log. GET responses are
+// served from and stored in the cache, and other requests invalidate it.
+func (c *Client) send(ctx context.Context, method, rawURL, path string, body []byte) ([]byte, http.Header, error) {
+	if method == http.MethodGet && c.Cache != nil {
+		if responseBody, header, ok := c.Cache.Get(rawURL); ok {
+			return responseBody, header, nil
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		responseBody, header, status, err := c.sendOnce(ctx, method, rawURL, path, body)
+		if err == nil {
+			if c.Cache != nil {
+				if method == http.MethodGet {
+					c.Cache.Set(rawURL, responseBody, header)
+				} else {
+					c.Cache.Invalidate(path)
+				}
+			}
+			return responseBody, header, nil
+		}
+		if attempt >= c.MaxRetries || (status != http.StatusTooManyRequests && status < 500) {
+			return nil, nil, err
+		}
+
+		// Wait before retrying, giving up early if the context is cancelled
+		timer := time.NewTimer(c.retryDelay(attempt, header))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryDelay returns how long to wait before retry number attempt, using the
+// Retry-After header when present and exponential backoff with jitter otherwise
+func (c *Client) retryDelay(attempt int, header http.Header) time.Duration {
+	delay := initialRetryDelay << attempt
+	delay += rand.N(delay / 2)
+
+	if retryAfter := header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			delay = time.Duration(seconds) * time.Second
+		} else if at, err := http.ParseTime(retryAfter); err == nil {
+			delay = time.Until(at)
+		}
+	}
+
+	if c.RetryMaxWait > 0 && delay > c.RetryMaxWait {
+		delay = c.RetryMaxWait
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	return delay
+}
+
+// parseAPIError builds an APIError from an error response body. Canvas
+// returns errors as a list ({"errors":[{"message":...}]}), as validation
+// errors keyed by attribute ({"errors":{"name":[{"type":...}]}}), or as a
+// single message ({"message":...}).
+func parseAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode, Body: string(body)}
+
+	var response struct {
+		Errors  json.RawMessage `json:"errors"`
+		Message string          `json:"message"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return apiErr
+	}
+
+	var list []APIErrorMessage
+	var byAttribute map[string][]APIErrorMessage
+	if err := json.Unmarshal(response.Errors, &list); err == nil {
+		apiErr.Errors = list
+	} else if err := json.Unmarshal(response.Errors, &byAttribute); err == nil {
+		// Sort attributes so the messages are in a stable order
+		attributes := make([]string, 0, len(byAttribute))
+		for attribute := range byAttribute {
+			attributes = append(attributes, attribute)
+		}
+		sort.Strings(attributes)
+
+		for _, attribute := range attributes {
+			for _, message := range byAttribute[attribute] {
+				if message.Attribute == "" {
+					message.Attribute = attribute
+				}
+				apiErr.Errors = append(apiErr.Errors, message)
+			}
+		}
+	}
+
+	if len(apiErr.Errors) == 0 && response.Message != "" {
+		apiErr.Errors = []APIErrorMessage{{Message: response.Message}}
+	}
+
+	return apiErr
+}
+
+// sendOnce makes a single request and returns the response body, headers,
+// and status code. The status code is 0 when no response was received.
+func (c *Client) sendOnce(ctx context.Context, method, rawURL, path string, body []byte) ([]byte, http.Header, int, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+
 	// Create the request
-	req, err := http.NewRequest(method, endpoint.String(), bytes.NewBuffer(jsonBody))
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, bodyReader)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		return nil, nil, 0, fmt.Errorf("error creating request: %w", err)
 	}
 
 	// Add headers
 	req.Header.Add("Authorization", "Bearer "+c.APIKey)
-	req.Header.Add("Content-Type", "application/json")
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	// Record the request in the session log once it completes
+	record := RequestRecord{Method: method, Path: path}
+	start := time.Now()
+	defer func() {
+		record.Duration = time.Since(start)
+		DefaultRequestLog.Record(record)
+	}()
 
 	// Send the request
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("error sending request: %w", err)
+		return nil, nil, 0, fmt.Errorf("error sending request: %w", err)
 	}
 	defer resp.Body.Close()
+	record.StatusCode = resp.StatusCode
 
 	// Check for errors
 	if resp.StatusCode >= 400 {
 		responseBody, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API error %d: %s", resp.StatusCode, string(responseBody))
+		record.Bytes = len(responseBody)
+		return nil, resp.Header, resp.StatusCode, parseAPIError(resp.StatusCode, responseBody)
 	}
 
 	// Read the response
 	responseBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading response: %w", err)
+		return nil, nil, resp.StatusCode, fmt.Errorf("error reading response: %w", err)
 	}
+	record.Bytes = len(responseBody)
 
-	return responseBody, nil
+	return responseBody, resp.Header, resp.StatusCode, nil
 }
 
-// GetCourses retrieves courses from Canvas
-func (c *Client) GetCourses() ([]Course, error) {
-	data, err := c.Request("GET", "/courses", nil)
+// GetSelf retrieves the authenticated user, which verifies the base URL and
+// API key
+func (c *Client) GetSelf() (*User, error) {
+	data, err := c.Request(c.context(), "GET", "/users/self", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var courses []Course
-	if err := json.Unmarshal(data, &courses); err != nil {
-		return nil, fmt.Errorf("error parsing courses: %w", err)
+	var user User
+	if err := json.Unmarshal(data, &user); err != nil {
+		return nil, fmt.Errorf("error parsing user: %w", err)
 	}
 
-	return courses, nil
+	return &user, nil
 }
 
-// GetAssignments retrieves assignments for a course
-func (c *Client) GetAssignments(courseID string) ([]Assignment, error) {
-	path := fmt.Sprintf("/courses/%s/assignments", courseID)
-	data, err := c.Request("GET", path, nil)
-	if err != nil {
-		return nil, err
+// addPagination adds the page and per_page query parameters, using
+// defaultPerPage when perPage is not set
+func addPagination(query url.Values, page, perPage, defaultPerPage int) {
+	if page > 0 {
+		query.Add("page", strconv.Itoa(page))
 	}
+	if perPage <= 0 {
+		perPage = defaultPerPage
+	}
+	query.Add("per_page", strconv.Itoa(perPage))
+}
 
-	var assignments []Assignment
-	if err := json.Unmarshal(data, &assignments); err != nil {
-		return nil, fmt.Errorf("error parsing assignments: %w", err)
+// requestPage makes a GET request for the given page, or for every page
+// when page is 0
+func (c *Client) requestPage(path string, query url.Values, page int, perPage int, defaultPerPage int) ([]byte, error) {
+	if page == 0 {
+		if perPage > 0 {
+			query.Set("per_page", strconv.Itoa(perPage))
+		}
+		return c.RequestAllPages(c.context(), "GET", path, query)
 	}
 
-	return assignments, nil
+	addPagination(query, page, perPage, defaultPerPage)
+	return c.Request(c.context(), "GET", path, query)
 }
 
-// GetUsers retrieves users for a course with pagination support
-func (c *Client) GetUsers(courseID string, page int, perPage int) ([]User, error) {
-	path := fmt.Sprintf("/courses/%s/users", courseID)
-	query := url.Values{}
-	query.Add("include[]", "email") // Include email addresses
+// GetCourses retrieves all courses from Canvas
+func (c *Client) GetCourses() ([]Course, error) {
+	return c.GetCoursesByEnrollmentType("", 0, 0)
+}
 
-	// Add pagination parameters
-	if page > 0 {
-		query.Add("page", strconv.Itoa(page))
-	}
-	if perPage > 0 {
-		query.Add("per_page", strconv.Itoa(perPage))
-	} else {
-		// Default to 50 per page if not specified
-		query.Add("per_page", "50")
+// GetCoursesByEnrollmentType retrieves a page of courses where the user has
+// the given enrollment type (student, teacher, ta, observer, or designer). An
+// empty enrollment type returns all courses. A page of 0 fetches every page.
+// Courses include their term.
+func (c *Client) GetCoursesByEnrollmentType(enrollmentType string, page int, perPage int) ([]Course, error) {
+	query := url.Values{}
+	query.Add("include[]", "term")
+	if enrollmentType != "" {
+		query.Add("enrollment_type", enrollmentType)
 	}
 
-	data, err := c.Request("GET", path, query)
+	data, err := c.requestPage("/courses", query, page, perPage, 50)
 	if err != nil {
 		return nil, err
 	}
 
-	var users []User
-	if err := json.Unmarshal(data, &users); err != nil {
-		return nil, fmt.Errorf("error parsing users: %w", err)
+	var courses []Course
+	if err := json.Unmarshal(data, &courses); err != nil {
+		return nil, fmt.Errorf("error parsing courses: %w", err)
 	}
 
-	return users, nil
+	return courses, nil
 }
 
-// GetUserDetails retrieves detailed information about a user
-func (c *Client) GetUserDetails(userID string) (*User, error) {
-	path := fmt.Sprintf("/users/%s", userID)
-	query := url.Values{}
-	query.Add("include[]", "email")
-
-	data, err := c.Request("GET", path, query)
+// GetCourse retrieves a single course by ID
+func (c *Client) GetCourse(courseID string) (*Course, error) {
+	path := fmt.Sprintf("/courses/%s", courseID)
+	data, err := c.Request(c.context(), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var user User
-	if err := json.Unmarshal(data, &user); err != nil {
-		return nil, fmt.Errorf("error parsing user details: %w", err)
+	var course Course
+	if err := json.Unmarshal(data, &course); err != nil {
+		return nil, fmt.Errorf("error parsing course: %w", err)
 	}
 
-	return &user, nil
+	return &course, nil
 }
 
-// EnrollmentRequest represents the request body for enrolling a user
-type EnrollmentRequest struct {
-	UserID          string `json:"user_id"`
-	Type            string `json:"type"`
-	EnrollmentState string `json:"enrollment_state,omitempty"`
-	CourseSection   string `json:"course_section_id,omitempty"`
-	LimitPrivileges bool   `json:"limit_privileges_to_course_section,omitempty"`
-	Notify          bool   `json:"notify,omitempty"`
-}
+// CreateCourse creates a new course in an account
+func (c *Client) CreateCourse(accountID string, course *Course) (*Course, error) {
+	path := fmt.Sprintf("/accounts/%s/courses", accountID)
 
-// AddUserToCourse enrolls a user in a course
-func (c *Client) AddUserToCourse(courseID, userID, enrollmentType string, notify bool) (*Enrollment, error) {
-	path := fmt.Sprintf("/courses/%s/enrollments", courseID)
+	fields := map[string]interface{}{
+		"name":                                 course.Name,
+		"restrict_enrollments_to_course_dates": course.RestrictEnrollments,
+	}
+	if course.CourseCode != "" {
+		fields["course_code"] = course.CourseCode
+	}
+	if course.SISCourseID != "" {
+		fields["sis_course_id"] = course.SISCourseID
+	}
+	if course.EnrollmentTermID != 0 {
+		fields["term_id"] = course.EnrollmentTermID
+	}
 
-	// Create the enrollment request
-	enrollReq := EnrollmentRequest{
-		UserID: userID,
-		Type:   enrollmentType, // e.g., "StudentEnrollment", "TeacherEnrollment", etc.
-		Notify: notify,
+	// For optional time fields, only include them if they are set
+	if !course.StartAt.IsZero() {
+		fields["start_at"] = course.StartAt.Format(time.RFC3339)
+	}
+	if !course.EndAt.IsZero() {
+		fields["end_at"] = course.EndAt.Format(time.RFC3339)
 	}
 
-	// Wrap in the enrollment object expected by the API
-	reqBody := map[string]EnrollmentRequest{
-		"enrollment": enrollReq,
+	requestBody := map[string]interface{}{
+		"course": fields,
 	}
 
-	data, err := c.RequestWithBody("POST", path, nil, reqBody)
+	data, err := c.RequestWithBody(c.context(), "POST", path, nil, requestBody)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error creating course: %w", err)
 	}
 
-	var enrollment Enrollment
-	if err := json.Unmarshal(data, &enrollment); err != nil {
-		return nil, fmt.Errorf("error parsing enrollment response: %w", err)
+	var newCourse Course
+	if err := json.Unmarshal(data, &newCourse); err != nil {
+		return nil, fmt.Errorf("error parsing course response: %w", err)
 	}
 
-	return &enrollment, nil
+	return &newCourse, nil
 }
 
-// GetEnrollments retrieves enrollments for a course
-func (c *Client) GetEnrollments(courseID string) ([]Enrollment, error) {
-	path := fmt.Sprintf("/courses/%s/enrollments", courseID)
+// UpdateCourse updates a course with the given course parameters, such as
+// name or start_at. Parameters that are not given are left unchanged.
+func (c *Client) UpdateCourse(courseID string, params map[string]interface{}) (*Course, error) {
+	path := fmt.Sprintf("/courses/%s", courseID)
+
+	requestBody := map[string]interface{}{
+		"course": params,
+	}
 
-	data, err := c.Request("GET", path, nil)
+	data, err := c.RequestWithBody(c.context(), "PUT", path, nil, requestBody)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error updating course: %w", err)
 	}
 
-	var enrollments []Enrollment
-	if err := json.Unmarshal(data, &enrollments); err != nil {
-		return nil, fmt.Errorf("error parsing enrollments: %w", err)
+	var updated Course
+	if err := json.Unmarshal(data, &updated); err != nil {
+		return nil, fmt.Errorf("error parsing course response: %w", err)
 	}
 
-	return enrollments, nil
+	return &updated, nil
 }
 
-// RemoveUserFromCourse deletes a user's enrollment in a course
-func (c *Client) RemoveUserFromCourse(courseID, enrollmentID string) error {
-	path := fmt.Sprintf("/courses/%s/enrollments/%s", courseID, enrollmentID)
+// GetSyllabus retrieves the HTML body of a course's syllabus
+func (c *Client) GetSyllabus(courseID string) (string, error) {
+	path := fmt.Sprintf("/courses/%s", courseID)
 	query := url.Values{}
-	query.Add("task", "delete")
+	query.Add("include[]", "syllabus_body")
+
+	data, err := c.Request(c.context(), "GET", path, query)
+	if err != nil {
+		return "", err
+	}
+
+	var course struct {
+		SyllabusBody string `json:"syllabus_body"`
+	}
+	if err := json.Unmarshal(data, &course); err != nil {
+		return "", fmt.Errorf("error parsing course: %w", err)
+	}
 
-	_, err := c.Request("DELETE", path, query)
+	return course.SyllabusBody, nil
+}
+
+// UpdateSyllabus replaces the HTML body of a course's syllabus
+func (c *Client) UpdateSyllabus(courseID, htmlBody string) error {
+	_, err := c.UpdateCourse(courseID, map[string]interface{}{
+		"syllabus_body": htmlBody,
+	})
 	return err
 }
 
-// RemoveUserByID removes a user from a course by user ID
-func (c *Client) RemoveUserByID(courseID, userID string) error {
-	// First, get all enrollments for the course
-	enrollments, err := c.GetEnrollments(courseID)
+// GetGradingStandards retrieves the grading standards available to a course,
+// including those of its accounts
+func (c *Client) GetGradingStandards(courseID string) ([]GradingStandard, error) {
+	path := fmt.Sprintf("/courses/%s/grading_standards", courseID)
+	data, err := c.RequestAllPages(c.context(), "GET", path, url.Values{})
 	if err != nil {
-		return fmt.Errorf("error fetching enrollments: %w", err)
+		return nil, err
 	}
 
-	// Convert userID to int for comparison
-	uid, err := strconv.Atoi(userID)
+	var standards []GradingStandard
+	if err := json.Unmarshal(data, &standards); err != nil {
+		return nil, fmt.Errorf("error parsing grading standards: %w", err)
+	}
+
+	return standards, nil
+}
+
+// GetGradingStandard retrieves a single grading standard of a course
+func (c *Client) GetGradingStandard(courseID, standardID string) (*GradingStandard, error) {
+	path := fmt.Sprintf("/courses/%s/grading_standards/%s", courseID, standardID)
+	data, err := c.Request(c.context(), "GET", path, nil)
 	if err != nil {
-		return fmt.Errorf("invalid user ID: %w", err)
+		return nil, err
 	}
 
-	// Find the enrollment for this user
-	var found bool
-	for _, enrollment := range enrollments {
-		if enrollment.UserID == uid {
-			// Found the enrollment, now remove it
-			err := c.RemoveUserFromCourse(courseID, strconv.Itoa(enrollment.ID))
-			if err != nil {
-				return fmt.Errorf("error removing enrollment: %w", err)
-			}
-			found = true
-			break
-		}
+	var standard GradingStandard
+	if err := json.Unmarshal(data, &standard); err != nil {
+		return nil, fmt.Errorf("error parsing grading standard: %w", err)
+	}
+
+	return &standard, nil
+}
+
+// GetLatePolicy retrieves a course's late policy. Canvas has no policy for a
+// course until one is saved, so a course without one gets the default, which
+// deducts nothing and has an ID of 0.
+func (c *Client) GetLatePolicy(courseID string) (*LatePolicy, error) {
+	path := fmt.Sprintf("/courses/%s/late_policy", courseID)
+	data, err := c.Request(c.context(), "GET", path, nil)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return &LatePolicy{LateSubmissionInterval: "day"}, nil
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	if !found {
-		return fmt.Errorf("no enrollment found for user %s in course %s", userID, courseID)
+	var response struct {
+		LatePolicy LatePolicy `json:"late_policy"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("error parsing late policy: %w", err)
 	}
 
-	return nil
+	return &response.LatePolicy, nil
 }
 
-// CreateAssignment creates a new assignment in a course
-func (c *Client) CreateAssignment(courseID string, assignment *Assignment) (*Assignment, error) {
-	path := fmt.Sprintf("/courses/%s/assignments", courseID)
+// UpdateLatePolicy saves a course's late policy, creating it when the policy
+// has no ID because the course did not have one yet
+func (c *Client) UpdateLatePolicy(courseID string, policy *LatePolicy) error {
+	path := fmt.Sprintf("/courses/%s/late_policy", courseID)
+	method := "PATCH"
+	if policy.ID == 0 {
+		method = "POST"
+	}
 
-	// Create the request body
 	requestBody := map[string]interface{}{
-		"assignment": map[string]interface{}{
-			"name":             assignment.Name,
-			"description":      assignment.Description,
-			"points_possible":  assignment.PointsPossible,
-			"due_at":           assignment.DueAt.Format(time.RFC3339),
-			"published":        assignment.Published,
-			"grading_type":     assignment.GradingType,
-			"submission_types": assignment.SubmissionTypes,
+		"late_policy": map[string]interface{}{
+			"missing_submission_deduction_enabled":    policy.MissingSubmissionDeductionEnabled,
+			"missing_submission_deduction":            policy.MissingSubmissionDeduction,
+			"late_submission_deduction_enabled":       policy.LateSubmissionDeductionEnabled,
+			"late_submission_deduction":               policy.LateSubmissionDeduction,
+			"late_submission_interval":                policy.LateSubmissionInterval,
+			"late_submission_minimum_percent_enabled": policy.LateSubmissionMinimumPercentEnabled,
+			"late_submission_minimum_percent":         policy.LateSubmissionMinimumPercent,
 		},
 	}
 
-	// For optional time fields, only include them if they are set
-	if !assignment.UnlockAt.IsZero() {
-		requestBody["assignment"].(map[string]interface{})["unlock_at"] = assignment.UnlockAt.Format(time.RFC3339)
+	_, err := c.RequestWithBody(c.context(), method, path, nil, requestBody)
+	return err
+}
+
+// CopyCourse creates a new course named destCourseName in the source course's
+// account and starts copying the source course's content into it. The copy
+// runs in the background; poll it with GetContentMigration. Under DryRun no
+// course is created, so the copy is not started and an empty migration is
+// returned.
+func (c *Client) CopyCourse(source *Course, destCourseName string) (*ContentMigration, error) {
+	dest, err := c.CreateCourse(strconv.Itoa(source.AccountID), &Course{
+		Name:       destCourseName,
+		CourseCode: source.CourseCode,
+	})
+	if err != nil {
+		return nil, err
 	}
-	if !assignment.LockAt.IsZero() {
-		requestBody["assignment"].(map[string]interface{})["lock_at"] = assignment.LockAt.Format(time.RFC3339)
+	if DryRun {
+		return &ContentMigration{}, nil
 	}
 
-	// Make the API request
-	data, err := c.RequestWithBody("POST", path, nil, requestBody)
+	path := fmt.Sprintf("/courses/%d/content_migrations", dest.ID)
+	requestBody := map[string]interface{}{
+		"migration_type": "course_copy_importer",
+		"settings": map[string]interface{}{
+			"source_course_id": source.ID,
+		},
+	}
+
+	data, err := c.RequestWithBody(c.context(), "POST", path, nil, requestBody)
 	if err != nil {
-		return nil, fmt.Errorf("error creating assignment: %w", err)
+		return nil, fmt.Errorf("error starting course copy: %w", err)
 	}
 
-	// Parse the response
-	var newAssignment Assignment
-	if err := json.Unmarshal(data, &newAssignment); err != nil {
-		return nil, fmt.Errorf("error parsing assignment response: %w", err)
+	var migration ContentMigration
+	if err := json.Unmarshal(data, &migration); err != nil {
+		return nil, fmt.Errorf("error parsing content migration: %w", err)
 	}
+	migration.CourseID = dest.ID
 
-	return &newAssignment, nil
+	return &migration, nil
 }
 
-// GetAssignment retrieves a single assignment by ID
-func (c *Client) GetAssignment(courseID, assignmentID string) (*Assignment, error) {
-	path := fmt.Sprintf("/courses/%s/assignments/%s", courseID, assignmentID)
-	data, err := c.Request("GET", path, nil)
+// GetContentMigration retrieves the current state of a content migration.
+// It is polled for progress, so it always skips the cache.
+func (c *Client) GetContentMigration(courseID, migrationID string) (*ContentMigration, error) {
+	uncached := *c
+	uncached.Cache = nil
+
+	path := fmt.Sprintf("/courses/%s/content_migrations/%s", courseID, migrationID)
+	data, err := uncached.Request(c.context(), "GET", path, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var assignment Assignment
-	if err := json.Unmarshal(data, &assignment); err != nil {
-		return nil, fmt.Errorf("error parsing assignment: %w", err)
+	var migration ContentMigration
+	if err := json.Unmarshal(data, &migration); err != nil {
+		return nil, fmt.Errorf("error parsing content migration: %w", err)
 	}
+	migration.CourseID, _ = strconv.Atoi(courseID)
 
-	return &assignment, nil
+	return &migration, nil
+}
+
+// GetFavoriteCourses retrieves the courses the current user has marked as favorites
+func (c *Client) GetFavoriteCourses() ([]Course, error) {
+	query := url.Values{}
+
+	data, err := c.RequestAllPages(c.context(), "GET", "/users/self/favorites/courses", query)
+	if err != nil {
+		return nil, err
+	}
+
+	var courses []Course
+	if err := json.Unmarshal(data, &courses); err != nil {
+		return nil, fmt.Errorf("error parsing favorite courses: %w", err)
+	}
+
+	return courses, nil
+}
+
+// GetAssignments retrieves a page of assignments for a course. A page of 0
+// fetches every page.
+func (c *Client) GetAssignments(courseID string, page int, perPage int) ([]Assignment, error) {
+	return c.GetAssignmentsByBucket(courseID, "", page, perPage)
+}
+
+// GetAssignmentsForCourses retrieves every assignment of several courses,
+// fetching up to concurrency courses at a time. The assignments are keyed by
+// course ID. Courses that could not be fetched are left out of the map and
+// have a *CourseError in the returned errors.
+func (c *Client) GetAssignmentsForCourses(courseIDs []string, concurrency int) (map[string][]Assignment, []error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	type courseResult struct {
+		courseID    string
+		assignments []Assignment
+		err         error
+	}
+
+	jobs := make(chan string)
+	results := make(chan courseResult)
+
+	// Start the workers, each fetching one course at a time
+	var wg sync.WaitGroup
+	for i := 0; i < min(concurrency, len(courseIDs)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for courseID := range jobs {
+				assignments, err := c.GetAssignments(courseID, 0, 0)
+				results <- courseResult{courseID: courseID, assignments: assignments, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		for _, courseID := range courseIDs {
+			jobs <- courseID
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	assignments := make(map[string][]Assignment, len(courseIDs))
+	var errs []error
+	for result := range results {
+		if result.err != nil {
+			errs = append(errs, &CourseError{CourseID: result.courseID, Err: result.err})
+			continue
+		}
+		assignments[result.courseID] = result.assignments
+	}
+	return assignments, errs
+}
+
+// GetAssignmentsByBucket retrieves a page of assignments for a course in the
+// given bucket (past, overdue, undated, ungraded, unsubmitted, upcoming, or
+// future), filtered by Canvas. An empty bucket returns all assignments. A
+// page of 0 fetches every page.
+func (c *Client) GetAssignmentsByBucket(courseID, bucket string, page int, perPage int) ([]Assignment, error) {
+	path := fmt.Sprintf("/courses/%s/assignments", courseID)
+	query := url.Values{}
+	if bucket != "" {
+		query.Add("bucket", bucket)
+	}
+
+	data, err := c.requestPage(path, query, page, perPage, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignments []Assignment
+	if err := json.Unmarshal(data, &assignments); err != nil {
+		return nil, fmt.Errorf("error parsing assignments: %w", err)
+	}
+
+	return assignments, nil
+}
+
+// GetUsers retrieves users for a course with pagination support. A page of 0
+// fetches every page.
+func (c *Client) GetUsers(courseID string, page int, perPage int) ([]User, error) {
+	path := fmt.Sprintf("/courses/%s/users", courseID)
+	query := url.Values{}
+	query.Add("include[]", "email") // Include email addresses
+
+	data, err := c.requestPage(path, query, page, perPage, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []User
+	if err := json.Unmarshal(data, &users); err != nil {
+		return nil, fmt.Errorf("error parsing users: %w", err)
+	}
+
+	return users, nil
+}
+
+// SearchUsers finds the users of an account whose name, login ID, email, or
+// SIS ID contains query. Use "self" for the root account of the current user.
+func (c *Client) SearchUsers(query string, accountID string) ([]User, error) {
+	path := fmt.Sprintf("/accounts/%s/users", accountID)
+	params := url.Values{}
+	params.Add("search_term", query)
+	params.Add("include[]", "email")
+
+	data, err := c.requestPage(path, params, 0, 100, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []User
+	if err := json.Unmarshal(data, &users); err != nil {
+		return nil, fmt.Errorf("error parsing users: %w", err)
+	}
+
+	return users, nil
+}
+
+// CreateUser creates a user in an account with a login of loginID, which is
+// also added as the user's email address. An empty authProviderID uses Canvas
+// logins. With forcePasswordChange, password is ignored and the user is sent
+// an email asking them to finish registering by choosing a password.
+func (c *Client) CreateUser(accountID string, user *User, loginID, password, authProviderID string, forcePasswordChange bool) (*User, error) {
+	path := fmt.Sprintf("/accounts/%s/users", accountID)
+
+	userFields := map[string]interface{}{
+		"name":              user.Name,
+		"skip_registration": !forcePasswordChange,
+	}
+	if user.ShortName != "" {
+		userFields["short_name"] = user.ShortName
+	}
+	if user.SortableName != "" {
+		userFields["sortable_name"] = user.SortableName
+	}
+
+	pseudonym := map[string]interface{}{
+		"unique_id":               loginID,
+		"force_self_registration": forcePasswordChange,
+	}
+	if password != "" && !forcePasswordChange {
+		pseudonym["password"] = password
+	}
+	if user.SISUserID != "" {
+		pseudonym["sis_user_id"] = user.SISUserID
+	}
+	if authProviderID != "" {
+		pseudonym["authentication_provider_id"] = authProviderID
+	}
+
+	requestBody := map[string]interface{}{
+		"user":      userFields,
+		"pseudonym": pseudonym,
+		"communication_channel": map[string]interface{}{
+			"type":              "email",
+			"address":           loginID,
+			"skip_confirmation": true,
+		},
+	}
+
+	data, err := c.RequestWithBody(c.context(), "POST", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating user: %w", err)
+	}
+
+	var newUser User
+	if err := json.Unmarshal(data, &newUser); err != nil {
+		return nil, fmt.Errorf("error parsing user response: %w", err)
+	}
+
+	return &newUser, nil
+}
+
+// GetUserDetails retrieves detailed information about a user
+func (c *Client) GetUserDetails(userID string) (*User, error) {
+	path := fmt.Sprintf("/users/%s", userID)
+	query := url.Values{}
+	query.Add("include[]", "email")
+
+	data, err := c.Request(c.context(), "GET", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var user User
+	if err := json.Unmarshal(data, &user); err != nil {
+		return nil, fmt.Errorf("error parsing user details: %w", err)
+	}
+
+	return &user, nil
+}
+
+// GetUserBySIS retrieves a user by their SIS user ID, using Canvas's
+// sis_user_id: ID prefix
+func (c *Client) GetUserBySIS(sisUserID string) (*User, error) {
+	return c.GetUserDetails("sis_user_id:" + sisUserID)
+}
+
+// GetUserProfile retrieves the profile of a user, including the avatar URL
+func (c *Client) GetUserProfile(userID string) (*UserProfile, error) {
+	path := fmt.Sprintf("/users/%s/profile", userID)
+
+	data, err := c.Request(c.context(), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var profile UserProfile
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("error parsing user profile: %w", err)
+	}
+
+	return &profile, nil
+}
+
+// EnrollmentRequest represents the request body for enrolling a user
+type EnrollmentRequest struct {
+	UserID          string `json:"user_id"`
+	Type            string `json:"type"`
+	RoleID          int    `json:"role_id,omitempty"`
+	EnrollmentState string `json:"enrollment_state,omitempty"`
+	CourseSection   string `json:"course_section_id,omitempty"`
+	LimitPrivileges bool   `json:"limit_privileges_to_course_section,omitempty"`
+	Notify          bool   `json:"notify,omitempty"`
+}
+
+// AddUserToCourse enrolls a user in a course. When sectionID is set the user
+// is enrolled in that section rather than the course's default section.
+func (c *Client) AddUserToCourse(courseID, userID, enrollmentType, sectionID string, notify bool) (*Enrollment, error) {
+	path := fmt.Sprintf("/courses/%s/enrollments", courseID)
+
+	// Create the enrollment request
+	enrollReq := EnrollmentRequest{
+		UserID:        userID,
+		Type:          enrollmentType, // e.g., "StudentEnrollment", "TeacherEnrollment", etc.
+		CourseSection: sectionID,
+		Notify:        notify,
+	}
+
+	// Wrap in the enrollment object expected by the API
+	reqBody := map[string]EnrollmentRequest{
+		"enrollment": enrollReq,
+	}
+
+	data, err := c.RequestWithBody(c.context(), "POST", path, nil, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var enrollment Enrollment
+	if err := json.Unmarshal(data, &enrollment); err != nil {
+		return nil, fmt.Errorf("error parsing enrollment response: %w", err)
+	}
+
+	return &enrollment, nil
+}
+
+// GetEnrollments retrieves a page of enrollments for a course. A page of 0
+// fetches every page.
+func (c *Client) GetEnrollments(courseID string, page int, perPage int) ([]Enrollment, error) {
+	path := fmt.Sprintf("/courses/%s/enrollments", courseID)
+	query := url.Values{}
+
+	data, err := c.requestPage(path, query, page, perPage, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	var enrollments []Enrollment
+	if err := json.Unmarshal(data, &enrollments); err != nil {
+		return nil, fmt.Errorf("error parsing enrollments: %w", err)
+	}
+
+	return enrollments, nil
+}
+
+// GetEnrollmentsForGradingPeriod retrieves all enrollments in a course with
+// their grades for one grading period
+func (c *Client) GetEnrollmentsForGradingPeriod(courseID, gradingPeriodID string) ([]Enrollment, error) {
+	path := fmt.Sprintf("/courses/%s/enrollments", courseID)
+	query := url.Values{}
+	query.Add("grading_period_id", gradingPeriodID)
+
+	data, err := c.requestPage(path, query, 0, 0, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	var enrollments []Enrollment
+	if err := json.Unmarshal(data, &enrollments); err != nil {
+		return nil, fmt.Errorf("error parsing enrollments: %w", err)
+	}
+
+	return enrollments, nil
+}
+
+// GetGradingPeriods retrieves the grading periods of a course, which is empty
+// when the course does not use grading periods
+func (c *Client) GetGradingPeriods(courseID string) ([]GradingPeriod, error) {
+	path := fmt.Sprintf("/courses/%s/grading_periods", courseID)
+	data, err := c.Request(c.context(), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		GradingPeriods []GradingPeriod `json:"grading_periods"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("error parsing grading periods: %w", err)
+	}
+
+	return response.GradingPeriods, nil
+}
+
+// GetSections retrieves the sections of a course
+func (c *Client) GetSections(courseID string) ([]Section, error) {
+	path := fmt.Sprintf("/courses/%s/sections", courseID)
+	query := url.Values{}
+	query.Add("include[]", "total_students")
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var sections []Section
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return nil, fmt.Errorf("error parsing sections: %w", err)
+	}
+
+	return sections, nil
+}
+
+// RemoveUserFromCourse deletes a user's enrollment in a course
+func (c *Client) RemoveUserFromCourse(courseID, enrollmentID string) error {
+	path := fmt.Sprintf("/courses/%s/enrollments/%s", courseID, enrollmentID)
+	query := url.Values{}
+	query.Add("task", "delete")
+
+	_, err := c.Request(c.context(), "DELETE", path, query)
+	return err
+}
+
+// ConcludeEnrollment concludes an enrollment, ending the user's participation
+// in the course while keeping their grades
+func (c *Client) ConcludeEnrollment(courseID, enrollmentID string) error {
+	path := fmt.Sprintf("/courses/%s/enrollments/%s", courseID, enrollmentID)
+	query := url.Values{}
+	query.Add("task", "conclude")
+
+	_, err := c.Request(c.context(), "DELETE", path, query)
+	return err
+}
+
+// DeactivateEnrollment deactivates an enrollment, hiding the course from the
+// user while keeping the enrollment so it can be reactivated
+func (c *Client) DeactivateEnrollment(courseID, enrollmentID string) error {
+	path := fmt.Sprintf("/courses/%s/enrollments/%s", courseID, enrollmentID)
+	query := url.Values{}
+	query.Add("task", "inactivate")
+
+	_, err := c.Request(c.context(), "DELETE", path, query)
+	return err
+}
+
+// ResendEnrollmentInvitation emails the user of a pending (invited) enrollment
+// a new invitation. Canvas has no endpoint for resending invitations, but
+// enrolling the user again in the same section and role with notify set
+// returns the existing enrollment and sends the invitation email again.
+func (c *Client) ResendEnrollmentInvitation(courseID string, enrollment Enrollment) error {
+	path := fmt.Sprintf("/courses/%s/enrollments", courseID)
+
+	enrollReq := EnrollmentRequest{
+		UserID:          strconv.Itoa(enrollment.UserID),
+		Type:            enrollment.Type,
+		RoleID:          enrollment.RoleID,
+		EnrollmentState: "invited",
+		LimitPrivileges: enrollment.LimitPrivileges,
+		Notify:          true,
+	}
+	if enrollment.CourseSectionID != 0 {
+		enrollReq.CourseSection = strconv.Itoa(enrollment.CourseSectionID)
+	}
+
+	reqBody := map[string]EnrollmentRequest{
+		"enrollment": enrollReq,
+	}
+
+	_, err := c.RequestWithBody(c.context(), "POST", path, nil, reqBody)
+	return err
+}
+
+// RemoveUserByID removes a user from a course by user ID, including every
+// role the user has in the course
+func (c *Client) RemoveUserByID(courseID, userID string) error {
+	_, err := c.RemoveUserEnrollments(courseID, userID, "")
+	return err
+}
+
+// RemoveUserEnrollments removes a user's enrollments of enrollmentType from a
+// course, or all of the user's enrollments when enrollmentType is empty. It
+// returns the enrollments that were removed.
+func (c *Client) RemoveUserEnrollments(courseID, userID, enrollmentType string) ([]Enrollment, error) {
+	// First, get all enrollments for the course
+	enrollments, err := c.GetEnrollments(courseID, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching enrollments: %w", err)
+	}
+
+	// Convert userID to int for comparison
+	uid, err := strconv.Atoi(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user ID: %w", err)
+	}
+
+	// Collect the user's enrollments before removing any of them
+	var matching []Enrollment
+	for _, enrollment := range enrollments {
+		if enrollment.UserID == uid && (enrollmentType == "" || enrollment.Type == enrollmentType) {
+			matching = append(matching, enrollment)
+		}
+	}
+
+	if len(matching) == 0 {
+		if enrollmentType != "" {
+			return nil, fmt.Errorf("no %s found for user %s in course %s", enrollmentType, userID, courseID)
+		}
+		return nil, fmt.Errorf("no enrollment found for user %s in course %s", userID, courseID)
+	}
+
+	for i, enrollment := range matching {
+		if err := c.RemoveUserFromCourse(courseID, strconv.Itoa(enrollment.ID)); err != nil {
+			return matching[:i], fmt.Errorf("error removing enrollment: %w", err)
+		}
+	}
+
+	return matching, nil
+}
+
+// GetGroups retrieves the groups of a course
+func (c *Client) GetGroups(courseID string) ([]Group, error) {
+	path := fmt.Sprintf("/courses/%s/groups", courseID)
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []Group
+	if err := json.Unmarshal(data, &groups); err != nil {
+		return nil, fmt.Errorf("error parsing groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+// GetGroupCategories retrieves the group categories (group sets) of a course
+func (c *Client) GetGroupCategories(courseID string) ([]GroupCategory, error) {
+	path := fmt.Sprintf("/courses/%s/group_categories", courseID)
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var categories []GroupCategory
+	if err := json.Unmarshal(data, &categories); err != nil {
+		return nil, fmt.Errorf("error parsing group categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// GetGroupMembers retrieves the users in a group
+func (c *Client) GetGroupMembers(groupID string) ([]User, error) {
+	path := fmt.Sprintf("/groups/%s/users", groupID)
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var users []User
+	if err := json.Unmarshal(data, &users); err != nil {
+		return nil, fmt.Errorf("error parsing group members: %w", err)
+	}
+
+	return users, nil
+}
+
+// CreateAssignment creates a new assignment in a course
+func (c *Client) CreateAssignment(courseID string, assignment *Assignment) (*Assignment, error) {
+	path := fmt.Sprintf("/courses/%s/assignments", courseID)
+
+	// Create the request body
+	requestBody := map[string]interface{}{
+		"assignment": map[string]interface{}{
+			"name":             assignment.Name,
+			"description":      assignment.Description,
+			"points_possible":  assignment.PointsPossible,
+			"published":        assignment.Published,
+			"grading_type":     assignment.GradingType,
+			"submission_types": assignment.SubmissionTypes,
+		},
+	}
+
+	// For optional time fields, only include them if they are set
+	if !assignment.DueAt.IsZero() {
+		requestBody["assignment"].(map[string]interface{})["due_at"] = assignment.DueAt.Format(time.RFC3339)
+	}
+	if !assignment.UnlockAt.IsZero() {
+		requestBody["assignment"].(map[string]interface{})["unlock_at"] = assignment.UnlockAt.Format(time.RFC3339)
+	}
+	if !assignment.LockAt.IsZero() {
+		requestBody["assignment"].(map[string]interface{})["lock_at"] = assignment.LockAt.Format(time.RFC3339)
+	}
+
+	// Make the API request
+	data, err := c.RequestWithBody(c.context(), "POST", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating assignment: %w", err)
+	}
+
+	// Parse the response
+	var newAssignment Assignment
+	if err := json.Unmarshal(data, &newAssignment); err != nil {
+		return nil, fmt.Errorf("error parsing assignment response: %w", err)
+	}
+
+	return &newAssignment, nil
+}
+
+// UpdateAssignment updates an existing assignment with the given params, so
+// callers send only the fields they change
+func (c *Client) UpdateAssignment(courseID string, assignmentID int, params map[string]interface{}) (*Assignment, error) {
+	path := fmt.Sprintf("/courses/%s/assignments/%d", courseID, assignmentID)
+
+	requestBody := map[string]interface{}{
+		"assignment": params,
+	}
+
+	data, err := c.RequestWithBody(c.context(), "PUT", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error updating assignment: %w", err)
+	}
+
+	var updated Assignment
+	if err := json.Unmarshal(data, &updated); err != nil {
+		return nil, fmt.Errorf("error parsing assignment response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// SetAssignmentPublished publishes or unpublishes an assignment, sending only
+// the published flag so no other field is overwritten
+func (c *Client) SetAssignmentPublished(courseID string, assignmentID int, published bool) error {
+	path := fmt.Sprintf("/courses/%s/assignments/%d", courseID, assignmentID)
+
+	requestBody := map[string]interface{}{
+		"assignment": map[string]interface{}{
+			"published": published,
+		},
+	}
+
+	if _, err := c.RequestWithBody(c.context(), "PUT", path, nil, requestBody); err != nil {
+		return fmt.Errorf("error updating assignment: %w", err)
+	}
+	return nil
+}
+
+// GetAssignment retrieves a single assignment by ID
+func (c *Client) GetAssignment(courseID, assignmentID string) (*Assignment, error) {
+	path := fmt.Sprintf("/courses/%s/assignments/%s", courseID, assignmentID)
+	data, err := c.Request(c.context(), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignment Assignment
+	if err := json.Unmarshal(data, &assignment); err != nil {
+		return nil, fmt.Errorf("error parsing assignment: %w", err)
+	}
+
+	return &assignment, nil
+}
+
+// GetAssignmentOverrides retrieves the due date overrides of an assignment
+func (c *Client) GetAssignmentOverrides(courseID, assignmentID string) ([]AssignmentOverride, error) {
+	path := fmt.Sprintf("/courses/%s/assignments/%s/overrides", courseID, assignmentID)
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var overrides []AssignmentOverride
+	if err := json.Unmarshal(data, &overrides); err != nil {
+		return nil, fmt.Errorf("error parsing assignment overrides: %w", err)
+	}
+
+	return overrides, nil
+}
+
+// CreateAssignmentOverride creates a due date override for either the
+// override's students or its section
+func (c *Client) CreateAssignmentOverride(courseID, assignmentID string, override *AssignmentOverride) (*AssignmentOverride, error) {
+	path := fmt.Sprintf("/courses/%s/assignments/%s/overrides", courseID, assignmentID)
+
+	fields := map[string]interface{}{}
+	if len(override.StudentIDs) > 0 {
+		fields["student_ids"] = override.StudentIDs
+	} else {
+		fields["course_section_id"] = override.CourseSectionID
+	}
+	if override.Title != "" {
+		fields["title"] = override.Title
+	}
+
+	// For optional time fields, only include them if they are set
+	if !override.DueAt.IsZero() {
+		fields["due_at"] = override.DueAt.Format(time.RFC3339)
+	}
+	if !override.UnlockAt.IsZero() {
+		fields["unlock_at"] = override.UnlockAt.Format(time.RFC3339)
+	}
+	if !override.LockAt.IsZero() {
+		fields["lock_at"] = override.LockAt.Format(time.RFC3339)
+	}
+
+	requestBody := map[string]interface{}{
+		"assignment_override": fields,
+	}
+
+	data, err := c.RequestWithBody(c.context(), "POST", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating assignment override: %w", err)
+	}
+
+	var created AssignmentOverride
+	if err := json.Unmarshal(data, &created); err != nil {
+		return nil, fmt.Errorf("error parsing assignment override response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetAssignmentGroups retrieves the assignment groups of a course with their assignments
+func (c *Client) GetAssignmentGroups(courseID string) ([]AssignmentGroup, error) {
+	path := fmt.Sprintf("/courses/%s/assignment_groups", courseID)
+	query := url.Values{}
+	query.Add("include[]", "assignments")
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []AssignmentGroup
+	if err := json.Unmarshal(data, &groups); err != nil {
+		return nil, fmt.Errorf("error parsing assignment groups: %w", err)
+	}
+
+	return groups, nil
+}
+
+// CreateAssignmentGroup creates an assignment group with the given weight
+func (c *Client) CreateAssignmentGroup(courseID, name string, weight float64) (*AssignmentGroup, error) {
+	path := fmt.Sprintf("/courses/%s/assignment_groups", courseID)
+	requestBody := map[string]interface{}{
+		"name":         name,
+		"group_weight": weight,
+	}
+
+	data, err := c.RequestWithBody(c.context(), "POST", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating assignment group: %w", err)
+	}
+
+	var group AssignmentGroup
+	if err := json.Unmarshal(data, &group); err != nil {
+		return nil, fmt.Errorf("error parsing assignment group response: %w", err)
+	}
+
+	return &group, nil
+}
+
+// GetRubrics retrieves the rubrics of a course
+func (c *Client) GetRubrics(courseID string) ([]Rubric, error) {
+	path := fmt.Sprintf("/courses/%s/rubrics", courseID)
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var rubrics []Rubric
+	if err := json.Unmarshal(data, &rubrics); err != nil {
+		return nil, fmt.Errorf("error parsing rubrics: %w", err)
+	}
+
+	return rubrics, nil
+}
+
+// GetRubric retrieves a single rubric of a course
+func (c *Client) GetRubric(courseID, rubricID string) (*Rubric, error) {
+	path := fmt.Sprintf("/courses/%s/rubrics/%s", courseID, rubricID)
+	data, err := c.Request(c.context(), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rubric Rubric
+	if err := json.Unmarshal(data, &rubric); err != nil {
+		return nil, fmt.Errorf("error parsing rubric: %w", err)
+	}
+
+	return &rubric, nil
+}
+
+// AssociateRubric attaches a rubric to an assignment and uses it for grading
+func (c *Client) AssociateRubric(courseID, assignmentID, rubricID string) error {
+	path := fmt.Sprintf("/courses/%s/rubric_associations", courseID)
+	requestBody := map[string]interface{}{
+		"rubric_association": map[string]interface{}{
+			"rubric_id":        rubricID,
+			"association_id":   assignmentID,
+			"association_type": "Assignment",
+			"use_for_grading":  true,
+			"purpose":          "grading",
+		},
+	}
+
+	if _, err := c.RequestWithBody(c.context(), "POST", path, nil, requestBody); err != nil {
+		return fmt.Errorf("error associating rubric: %w", err)
+	}
+
+	return nil
+}
+
+// GetSubmissions retrieves a page of submissions for an assignment. A page of
+// 0 fetches every page.
+func (c *Client) GetSubmissions(courseID, assignmentID string, page int, perPage int) ([]Submission, error) {
+	path := fmt.Sprintf("/courses/%s/assignments/%s/submissions", courseID, assignmentID)
+	query := url.Values{}
+	query.Add("include[]", "user")
+	data, err := c.requestPage(path, query, page, perPage, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	var submissions []Submission
+	if err := json.Unmarshal(data, &submissions); err != nil {
+		return nil, fmt.Errorf("error parsing submissions: %w", err)
+	}
+
+	return submissions, nil
+}
+
+// GetSubmission retrieves a user's submission for an assignment, including
+// the user and any submission comments
+func (c *Client) GetSubmission(courseID, assignmentID, userID string) (*Submission, error) {
+	path := fmt.Sprintf("/courses/%s/assignments/%s/submissions/%s", courseID, assignmentID, userID)
+	query := url.Values{}
+	query.Add("include[]", "user")
+	query.Add("include[]", "submission_comments")
+
+	data, err := c.Request(c.context(), "GET", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var submission Submission
+	if err := json.Unmarshal(data, &submission); err != nil {
+		return nil, fmt.Errorf("error parsing submission: %w", err)
+	}
+
+	return &submission, nil
+}
+
+// GetSubmissionComments retrieves the comments on a user's submission, oldest first
+func (c *Client) GetSubmissionComments(courseID, assignmentID, userID string) ([]SubmissionComment, error) {
+	submission, err := c.GetSubmission(courseID, assignmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := submission.Comments
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+	})
+	return comments, nil
+}
+
+// AddSubmissionComment adds a comment to a user's submission without changing its grade
+func (c *Client) AddSubmissionComment(courseID, assignmentID, userID, comment string) error {
+	path := fmt.Sprintf("/courses/%s/assignments/%s/submissions/%s", courseID, assignmentID, userID)
+	requestBody := map[string]interface{}{
+		"comment": map[string]interface{}{
+			"text_comment": comment,
+		},
+	}
+
+	_, err := c.RequestWithBody(c.context(), "PUT", path, nil, requestBody)
+	return err
+}
+
+// GetSubmissionSummary retrieves the graded, ungraded, and not submitted counts for an assignment
+func (c *Client) GetSubmissionSummary(courseID, assignmentID string) (*SubmissionSummary, error) {
+	path := fmt.Sprintf("/courses/%s/assignments/%s/submission_summary", courseID, assignmentID)
+	data, err := c.Request(c.context(), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var summary SubmissionSummary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return nil, fmt.Errorf("error parsing submission summary: %w", err)
+	}
+
+	return &summary, nil
+}
+
+// GetStudentSubmissions retrieves a student's submissions for every assignment
+// in a course, including the assignment each submission belongs to
+func (c *Client) GetStudentSubmissions(courseID, userID string) ([]Submission, error) {
+	path := fmt.Sprintf("/courses/%s/students/submissions", courseID)
+	query := url.Values{}
+	query.Add("student_ids[]", userID)
+	query.Add("include[]", "assignment")
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var submissions []Submission
+	if err := json.Unmarshal(data, &submissions); err != nil {
+		return nil, fmt.Errorf("error parsing submissions: %w", err)
+	}
+
+	return submissions, nil
+}
+
+// GetCourseSubmissions retrieves the submissions of every student for every
+// assignment in a course in a single request rather than one per assignment
+func (c *Client) GetCourseSubmissions(courseID string) ([]Submission, error) {
+	path := fmt.Sprintf("/courses/%s/students/submissions", courseID)
+	query := url.Values{}
+	query.Add("student_ids[]", "all")
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var submissions []Submission
+	if err := json.Unmarshal(data, &submissions); err != nil {
+		return nil, fmt.Errorf("error parsing submissions: %w", err)
+	}
+
+	return submissions, nil
+}
+
+// GetMissingSubmissions retrieves the unsubmitted submissions of every
+// student in a course that Canvas has marked missing, including the
+// assignment and user, in a single request rather than one per assignment
+func (c *Client) GetMissingSubmissions(courseID string) ([]Submission, error) {
+	path := fmt.Sprintf("/courses/%s/students/submissions", courseID)
+	query := url.Values{}
+	query.Add("student_ids[]", "all")
+	query.Add("workflow_state", "unsubmitted")
+	query.Add("include[]", "assignment")
+	query.Add("include[]", "user")
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var submissions []Submission
+	if err := json.Unmarshal(data, &submissions); err != nil {
+		return nil, fmt.Errorf("error parsing submissions: %w", err)
+	}
+
+	// Unsubmitted work is only missing once Canvas has marked it so
+	var missing []Submission
+	for _, submission := range submissions {
+		if submission.Missing {
+			missing = append(missing, submission)
+		}
+	}
+
+	return missing, nil
+}
+
+// GradeSubmission sets the grade for a user's submission, optionally adding a comment
+func (c *Client) GradeSubmission(courseID, assignmentID, userID, grade, comment string) (*Submission, error) {
+	path := fmt.Sprintf("/courses/%s/assignments/%s/submissions/%s", courseID, assignmentID, userID)
+
+	requestBody := map[string]interface{}{
+		"submission": map[string]interface{}{
+			"posted_grade": grade,
+		},
+	}
+	if comment != "" {
+		requestBody["comment"] = map[string]interface{}{
+			"text_comment": comment,
+		}
+	}
+
+	data, err := c.RequestWithBody(c.context(), "PUT", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error grading submission: %w", err)
+	}
+
+	var submission Submission
+	if err := json.Unmarshal(data, &submission); err != nil {
+		return nil, fmt.Errorf("error parsing submission response: %w", err)
+	}
+
+	return &submission, nil
+}
+
+// GradeSubmissionWithRubric submits a full rubric assessment for a user's submission.
+// The assessment is keyed by criterion ID and the posted grade is set to the
+// sum of the assessed points.
+func (c *Client) GradeSubmissionWithRubric(courseID, assignmentID, userID string, assessment map[string]RubricAssessment) (*Submission, error) {
+	path := fmt.Sprintf("/courses/%s/assignments/%s/submissions/%s", courseID, assignmentID, userID)
+
+	var total float64
+	for _, criterion := range assessment {
+		total += criterion.Points
+	}
+
+	requestBody := map[string]interface{}{
+		"rubric_assessment": assessment,
+		"submission": map[string]interface{}{
+			"posted_grade": strconv.FormatFloat(total, 'f', -1, 64),
+		},
+	}
+
+	data, err := c.RequestWithBody(c.context(), "PUT", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error submitting rubric assessment: %w", err)
+	}
+
+	var submission Submission
+	if err := json.Unmarshal(data, &submission); err != nil {
+		return nil, fmt.Errorf("error parsing submission response: %w", err)
+	}
+
+	return &submission, nil
+}
+
+// GetPages retrieves the wiki pages for a course
+func (c *Client) GetPages(courseID string) ([]Page, error) {
+	path := fmt.Sprintf("/courses/%s/pages", courseID)
+	query := url.Values{}
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var pages []Page
+	if err := json.Unmarshal(data, &pages); err != nil {
+		return nil, fmt.Errorf("error parsing pages: %w", err)
+	}
+
+	return pages, nil
+}
+
+// GetPage retrieves a single wiki page, including its body
+func (c *Client) GetPage(courseID, pageURL string) (*Page, error) {
+	path := fmt.Sprintf("/courses/%s/pages/%s", courseID, pageURL)
+	data, err := c.Request(c.context(), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var page Page
+	if err := json.Unmarshal(data, &page); err != nil {
+		return nil, fmt.Errorf("error parsing page: %w", err)
+	}
+
+	return &page, nil
+}
+
+// UpdatePage sets the title and HTML body of a wiki page
+func (c *Client) UpdatePage(courseID, pageURL, title, body string) (*Page, error) {
+	path := fmt.Sprintf("/courses/%s/pages/%s", courseID, pageURL)
+
+	requestBody := map[string]interface{}{
+		"wiki_page": map[string]interface{}{
+			"title": title,
+			"body":  body,
+		},
+	}
+
+	data, err := c.RequestWithBody(c.context(), "PUT", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error updating page: %w", err)
+	}
+
+	var page Page
+	if err := json.Unmarshal(data, &page); err != nil {
+		return nil, fmt.Errorf("error parsing page response: %w", err)
+	}
+
+	return &page, nil
+}
+
+// SetPagePublished sets the published state of a wiki page
+func (c *Client) SetPagePublished(courseID, pageURL string, published bool) (*Page, error) {
+	path := fmt.Sprintf("/courses/%s/pages/%s", courseID, pageURL)
+
+	requestBody := map[string]interface{}{
+		"wiki_page": map[string]interface{}{
+			"published": published,
+		},
+	}
+
+	data, err := c.RequestWithBody(c.context(), "PUT", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error updating page: %w", err)
+	}
+
+	var page Page
+	if err := json.Unmarshal(data, &page); err != nil {
+		return nil, fmt.Errorf("error parsing page response: %w", err)
+	}
+
+	return &page, nil
+}
+
+// GetModules retrieves the modules for a course
+func (c *Client) GetModules(courseID string) ([]Module, error) {
+	path := fmt.Sprintf("/courses/%s/modules", courseID)
+	query := url.Values{}
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []Module
+	if err := json.Unmarshal(data, &modules); err != nil {
+		return nil, fmt.Errorf("error parsing modules: %w", err)
+	}
+
+	return modules, nil
+}
+
+// GetModuleItems retrieves the items of a module
+func (c *Client) GetModuleItems(courseID, moduleID string) ([]ModuleItem, error) {
+	path := fmt.Sprintf("/courses/%s/modules/%s/items", courseID, moduleID)
+	query := url.Values{}
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []ModuleItem
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("error parsing module items: %w", err)
+	}
+
+	return items, nil
+}
+
+// UpdateModulePublishedState publishes or unpublishes a module
+func (c *Client) UpdateModulePublishedState(courseID, moduleID string, published bool) error {
+	path := fmt.Sprintf("/courses/%s/modules/%s", courseID, moduleID)
+
+	requestBody := map[string]interface{}{
+		"module": map[string]interface{}{
+			"published": published,
+		},
+	}
+
+	if _, err := c.RequestWithBody(c.context(), "PUT", path, nil, requestBody); err != nil {
+		return fmt.Errorf("error updating module: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateModuleItemPublishedState publishes or unpublishes a single module item
+func (c *Client) UpdateModuleItemPublishedState(courseID, moduleID, itemID string, published bool) error {
+	path := fmt.Sprintf("/courses/%s/modules/%s/items/%s", courseID, moduleID, itemID)
+
+	requestBody := map[string]interface{}{
+		"module_item": map[string]interface{}{
+			"published": published,
+		},
+	}
+
+	if _, err := c.RequestWithBody(c.context(), "PUT", path, nil, requestBody); err != nil {
+		return fmt.Errorf("error updating module item: %w", err)
+	}
+
+	return nil
+}
+
+// GetDiscussions retrieves the discussion topics of a course
+func (c *Client) GetDiscussions(courseID string) ([]DiscussionTopic, error) {
+	path := fmt.Sprintf("/courses/%s/discussion_topics", courseID)
+	query := url.Values{}
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var topics []DiscussionTopic
+	if err := json.Unmarshal(data, &topics); err != nil {
+		return nil, fmt.Errorf("error parsing discussion topics: %w", err)
+	}
+
+	return topics, nil
+}
+
+// discussionView is the full threaded view of a discussion topic
+type discussionView struct {
+	Participants []struct {
+		ID          int    `json:"id"`
+		DisplayName string `json:"display_name"`
+	} `json:"participants"`
+	View []DiscussionEntry `json:"view"`
+}
+
+// GetDiscussionEntries retrieves every entry of a discussion topic as a
+// tree of top-level entries and their nested replies
+func (c *Client) GetDiscussionEntries(courseID, topicID string) ([]DiscussionEntry, error) {
+	path := fmt.Sprintf("/courses/%s/discussion_topics/%s/view", courseID, topicID)
+	data, err := c.Request(c.context(), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var view discussionView
+	if err := json.Unmarshal(data, &view); err != nil {
+		return nil, fmt.Errorf("error parsing discussion entries: %w", err)
+	}
+
+	// The view only carries user IDs, so fill in names from the participants
+	names := make(map[int]string, len(view.Participants))
+	for _, participant := range view.Participants {
+		names[participant.ID] = participant.DisplayName
+	}
+	var setNames func(entries []DiscussionEntry)
+	setNames = func(entries []DiscussionEntry) {
+		for i := range entries {
+			if entries[i].UserName == "" {
+				entries[i].UserName = names[entries[i].UserID]
+			}
+			setNames(entries[i].Replies)
+		}
+	}
+	setNames(view.View)
+
+	return view.View, nil
+}
+
+// PostDiscussionEntry posts a new top-level entry to a discussion topic
+func (c *Client) PostDiscussionEntry(courseID, topicID, message string) (*DiscussionEntry, error) {
+	path := fmt.Sprintf("/courses/%s/discussion_topics/%s/entries", courseID, topicID)
+	requestBody := map[string]interface{}{
+		"message": message,
+	}
+
+	data, err := c.RequestWithBody(c.context(), "POST", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error posting discussion entry: %w", err)
+	}
+
+	var entry DiscussionEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("error parsing discussion entry: %w", err)
+	}
+
+	return &entry, nil
+}
+
+// GetDiscussion retrieves a single discussion topic
+func (c *Client) GetDiscussion(courseID, topicID string) (*DiscussionTopic, error) {
+	path := fmt.Sprintf("/courses/%s/discussion_topics/%s", courseID, topicID)
+	data, err := c.Request(c.context(), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var topic DiscussionTopic
+	if err := json.Unmarshal(data, &topic); err != nil {
+		return nil, fmt.Errorf("error parsing discussion topic: %w", err)
+	}
+
+	return &topic, nil
+}
+
+// SubscribeToDiscussion subscribes the current user to a discussion topic
+func (c *Client) SubscribeToDiscussion(courseID, topicID string) error {
+	path := fmt.Sprintf("/courses/%s/discussion_topics/%s/subscribed", courseID, topicID)
+	_, err := c.Request(c.context(), "PUT", path, nil)
+	return err
+}
+
+// UnsubscribeFromDiscussion unsubscribes the current user from a discussion topic
+func (c *Client) UnsubscribeFromDiscussion(courseID, topicID string) error {
+	path := fmt.Sprintf("/courses/%s/discussion_topics/%s/subscribed", courseID, topicID)
+	_, err := c.Request(c.context(), "DELETE", path, nil)
+	return err
+}
+
+// GetAnnouncements retrieves the announcements of a course, newest first
+func (c *Client) GetAnnouncements(courseID string) ([]Announcement, error) {
+	query := url.Values{}
+	query.Add("context_codes[]", "course_"+courseID)
+
+	data, err := c.RequestAllPages(c.context(), "GET", "/announcements", query)
+	if err != nil {
+		return nil, err
+	}
+
+	var announcements []Announcement
+	if err := json.Unmarshal(data, &announcements); err != nil {
+		return nil, fmt.Errorf("error parsing announcements: %w", err)
+	}
+
+	return announcements, nil
+}
+
+// GetAnnouncement retrieves a single announcement. Announcements are
+// discussion topics, so they are fetched from the discussion topics endpoint.
+func (c *Client) GetAnnouncement(courseID, announcementID string) (*Announcement, error) {
+	path := fmt.Sprintf("/courses/%s/discussion_topics/%s", courseID, announcementID)
+	data, err := c.Request(c.context(), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var announcement Announcement
+	if err := json.Unmarshal(data, &announcement); err != nil {
+		return nil, fmt.Errorf("error parsing announcement: %w", err)
+	}
+
+	return &announcement, nil
+}
+
+// CreateAnnouncement posts a new announcement to a course
+func (c *Client) CreateAnnouncement(courseID, title, message string) (*Announcement, error) {
+	path := fmt.Sprintf("/courses/%s/discussion_topics", courseID)
+	requestBody := map[string]interface{}{
+		"title":           title,
+		"message":         message,
+		"is_announcement": true,
+		"published":       true,
+	}
+
+	data, err := c.RequestWithBody(c.context(), "POST", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error creating announcement: %w", err)
+	}
+
+	var announcement Announcement
+	if err := json.Unmarshal(data, &announcement); err != nil {
+		return nil, fmt.Errorf("error parsing announcement response: %w", err)
+	}
+
+	return &announcement, nil
+}
+
+// GetQuizzes retrieves the quizzes of a course
+func (c *Client) GetQuizzes(courseID string) ([]Quiz, error) {
+	path := fmt.Sprintf("/courses/%s/quizzes", courseID)
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var quizzes []Quiz
+	if err := json.Unmarshal(data, &quizzes); err != nil {
+		return nil, fmt.Errorf("error parsing quizzes: %w", err)
+	}
+
+	return quizzes, nil
+}
+
+// GetQuiz retrieves a single quiz by ID
+func (c *Client) GetQuiz(courseID, quizID string) (*Quiz, error) {
+	path := fmt.Sprintf("/courses/%s/quizzes/%s", courseID, quizID)
+	data, err := c.Request(c.context(), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var quiz Quiz
+	if err := json.Unmarshal(data, &quiz); err != nil {
+		return nil, fmt.Errorf("error parsing quiz: %w", err)
+	}
+
+	return &quiz, nil
+}
+
+// UpdateQuiz updates the settings of an existing quiz
+func (c *Client) UpdateQuiz(courseID, quizID string, quiz *Quiz) (*Quiz, error) {
+	path := fmt.Sprintf("/courses/%s/quizzes/%s", courseID, quizID)
+
+	quizBody := map[string]interface{}{
+		"title":            quiz.Title,
+		"description":      quiz.Description,
+		"quiz_type":        quiz.QuizType,
+		"allowed_attempts": quiz.AllowedAttempts,
+		"shuffle_answers":  quiz.ShuffleAnswers,
+		"published":        quiz.Published,
+	}
+
+	// A zero time limit means the quiz is untimed
+	if quiz.TimeLimit > 0 {
+		quizBody["time_limit"] = quiz.TimeLimit
+	} else {
+		quizBody["time_limit"] = nil
+	}
+
+	requestBody := map[string]interface{}{
+		"quiz": quizBody,
+	}
+
+	data, err := c.RequestWithBody(c.context(), "PUT", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error updating quiz: %w", err)
+	}
+
+	var updated Quiz
+	if err := json.Unmarshal(data, &updated); err != nil {
+		return nil, fmt.Errorf("error parsing quiz response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// SetQuizPublished publishes or unpublishes a quiz, sending only the
+// published flag so no other setting is overwritten
+func (c *Client) SetQuizPublished(courseID, quizID string, published bool) error {
+	path := fmt.Sprintf("/courses/%s/quizzes/%s", courseID, quizID)
+
+	requestBody := map[string]interface{}{
+		"quiz": map[string]interface{}{
+			"published": published,
+		},
+	}
+
+	if _, err := c.RequestWithBody(c.context(), "PUT", path, nil, requestBody); err != nil {
+		return fmt.Errorf("error updating quiz: %w", err)
+	}
+	return nil
 }
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCreateAssignmentDueAt(t *testing.T) {
+	dueAt := time.Date(2026, 10, 20, 23, 59, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		dueAt     time.Time
+		wantDueAt any // nil when due_at should be left out of the body
+	}{
+		{name: "due date set", dueAt: dueAt, wantDueAt: "2026-10-20T23:59:00Z"},
+		{name: "no due date", dueAt: time.Time{}, wantDueAt: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body struct {
+				Assignment map[string]any `json:"assignment"`
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/api/v1/courses/5/assignments" {
+					t.Errorf("got request %s %s, want POST /api/v1/courses/5/assignments", r.Method, r.URL.Path)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Errorf("decoding request body: %v", err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(`{"id": 9, "name": "Essay"}`))
+			}))
+			defer server.Close()
+
+			client := &Client{BaseURL: server.URL + "/api/v1", HTTPClient: server.Client()}
+			assignment := &Assignment{Name: "Essay", GradingType: "points", DueAt: tt.dueAt}
+			if _, err := client.CreateAssignment("5", assignment); err != nil {
+				t.Fatalf("CreateAssignment: %v", err)
+			}
+
+			got, ok := body.Assignment["due_at"]
+			if tt.wantDueAt == nil {
+				if ok {
+					t.Errorf("assignment[due_at] = %v, want it left out", got)
+				}
+				return
+			}
+			if got != tt.wantDueAt {
+				t.Errorf("assignment[due_at] = %v, want %v", got, tt.wantDueAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DebugLevel is how much clients created by NewClient log about each request
+// to stderr: 0 for nothing, 1 for one line per request, and 2 to also log
+// request and response bodies
+var DebugLevel int
+
+// maxDebugBody is the number of bytes of a body logged at debug level 2
+const maxDebugBody = 4 * 1024
+
+// DebugTransport is an http.RoundTripper that logs the method, URL, status,
+// duration, and response size of each request as key=value pairs. At Level 2
+// it also logs the first 4 KB of request and response bodies. The response
+// line is written when the body is closed, so the duration and size cover
+// the whole body.
+type DebugTransport struct {
+	Base  http.RoundTripper // transport making the requests, http.DefaultTransport when nil
+	Out   io.Writer
+	Level int
+	mu    sync.Mutex // keeps the lines of concurrent requests apart
+}
+
+// RoundTrip logs and sends a request
+func (t *DebugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	var requestBody []byte
+	if t.Level >= 2 && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			requestBody, _ = io.ReadAll(io.LimitReader(body, maxDebugBody+1))
+			body.Close()
+		}
+	}
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		t.write(fmt.Sprintf("method=%s url=%s error=%q duration=%s\n",
+			req.Method, req.URL, err.Error(), time.Since(start).Round(time.Millisecond)), requestBody, nil)
+		return nil, err
+	}
+
+	resp.Body = &debugBody{
+		ReadCloser:  resp.Body,
+		transport:   t,
+		req:         req,
+		status:      resp.StatusCode,
+		start:       start,
+		requestBody: requestBody,
+	}
+	return resp, nil
+}
+
+// write logs a request line followed, at level 2, by the bodies
+func (t *DebugTransport) write(line string, requestBody, responseBody []byte) {
+	var b strings.Builder
+	b.WriteString("[canvas-cli] " + line)
+	if t.Level >= 2 {
+		writeDebugBody(&b, "request", requestBody)
+		writeDebugBody(&b, "response", responseBody)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	io.WriteString(t.Out, b.String())
+}
+
+// writeDebugBody writes a labelled body, truncated to maxDebugBody bytes
+func writeDebugBody(b *strings.Builder, label string, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+	truncated := ""
+	if len(body) > maxDebugBody {
+		body = body[:maxDebugBody]
+		truncated = " (truncated to 4 KB)"
+	}
+	fmt.Fprintf(b, "  %s body%s:\n  %s\n", label, truncated, strings.ReplaceAll(strings.TrimSpace(string(body)), "\n", "\n  "))
+}
+
+// debugBody counts, and at level 2 keeps the start of, a response body as it
+// is read, and logs the request when it is closed
+type debugBody struct {
+	io.ReadCloser
+	transport   *DebugTransport
+	req         *http.Request
+	status      int
+	start       time.Time
+	requestBody []byte
+	size        int
+	captured    bytes.Buffer
+	once        sync.Once
+}
+
+// Read reads from the response body, counting and capturing what is read
+func (b *debugBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += n
+	if b.transport.Level >= 2 && b.captured.Len() <= maxDebugBody {
+		b.captured.Write(p[:min(n, maxDebugBody+1-b.captured.Len())])
+	}
+	return n, err
+}
+
+// Close closes the response body and logs the request
+func (b *debugBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		b.transport.write(fmt.Sprintf("method=%s url=%s status=%d duration=%s bytes=%d\n",
+			b.req.Method, b.req.URL, b.status, time.Since(b.start).Round(time.Millisecond), b.size),
+			b.requestBody, b.captured.Bytes())
+	})
+	return err
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// DryRun makes clients created by NewClient print write requests to stderr
+// instead of sending them
+var DryRun bool
+
+// DryRunTransport is an http.RoundTripper that passes GET and HEAD requests
+// through to Base but intercepts all other requests. It prints the method,
+// URL, and JSON body of each one with passwords and tokens redacted, and
+// answers with an empty JSON object so callers parse a zeroed value.
+type DryRunTransport struct {
+	Base http.RoundTripper // transport for reads, http.DefaultTransport when nil
+	Out  io.Writer
+	mu   sync.Mutex // keeps the descriptions of concurrent requests apart
+}
+
+// RoundTrip sends reads and describes writes
+func (t *DryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		base := t.Base
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		return base.RoundTrip(req)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "DRY RUN: %s %s\n", req.Method, req.URL)
+	if req.Body != nil {
+		body, _ := io.ReadAll(req.Body)
+		req.Body.Close()
+		if described := describeDryRunBody(req.Header.Get("Content-Type"), body); described != "" {
+			sb.WriteString("  " + strings.ReplaceAll(described, "\n", "\n  ") + "\n")
+		}
+	}
+
+	t.mu.Lock()
+	io.WriteString(t.Out, sb.String())
+	t.mu.Unlock()
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(strings.NewReader("{}")),
+		ContentLength: 2,
+		Request:       req,
+	}, nil
+}
+
+// describeDryRunBody returns an indented JSON body with sensitive values
+// redacted, or the size of a body that is not JSON
+func describeDryRunBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var value interface{}
+	if !strings.HasPrefix(contentType, "application/json") || json.Unmarshal(body, &value) != nil {
+		return fmt.Sprintf("(%d byte %s body)", len(body), contentType)
+	}
+
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(redactSensitive(value)); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(out.String())
+}
+
+// redactSensitive replaces the values of keys naming passwords, tokens, and
+// secrets in decoded JSON
+func redactSensitive(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			lower := strings.ToLower(key)
+			if strings.Contains(lower, "password") || strings.Contains(lower, "token") || strings.Contains(lower, "secret") {
+				v[key] = "[REDACTED]"
+			} else {
+				v[key] = redactSensitive(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactSensitive(item)
+		}
+	}
+	return value
+}
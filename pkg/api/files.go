@@ -0,0 +1,217 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// GetFolders retrieves every folder of a course
+func (c *Client) GetFolders(courseID string) ([]Folder, error) {
+	path := fmt.Sprintf("/courses/%s/folders", courseID)
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var folders []Folder
+	if err := json.Unmarshal(data, &folders); err != nil {
+		return nil, fmt.Errorf("error parsing folders: %w", err)
+	}
+
+	return folders, nil
+}
+
+// GetFiles retrieves the files in a folder, or every file of the course when
+// folderID is empty
+func (c *Client) GetFiles(courseID, folderID string) ([]File, error) {
+	path := fmt.Sprintf("/courses/%s/files", courseID)
+	if folderID != "" {
+		path = fmt.Sprintf("/folders/%s/files", folderID)
+	}
+
+	data, err := c.RequestAllPages(c.context(), "GET", path, url.Values{})
+	if err != nil {
+		return nil, err
+	}
+
+	var files []File
+	if err := json.Unmarshal(data, &files); err != nil {
+		return nil, fmt.Errorf("error parsing files: %w", err)
+	}
+
+	return files, nil
+}
+
+// GetFile retrieves a single file of a course
+func (c *Client) GetFile(courseID, fileID string) (*File, error) {
+	path := fmt.Sprintf("/courses/%s/files/%s", courseID, fileID)
+	data, err := c.Request(c.context(), "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var file File
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("error parsing file: %w", err)
+	}
+
+	return &file, nil
+}
+
+// DownloadFile writes the contents of a file to dest
+func (c *Client) DownloadFile(fileID string, dest io.Writer) error {
+	data, err := c.Request(c.context(), "GET", "/files/"+fileID, nil)
+	if err != nil {
+		return err
+	}
+
+	var file File
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("error parsing file: %w", err)
+	}
+	if file.URL == "" {
+		return fmt.Errorf("file %s has no download URL", fileID)
+	}
+
+	// The download URL is pre-signed, so it is fetched without the API key
+	req, err := http.NewRequestWithContext(c.context(), "GET", file.URL, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error downloading file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return parseAPIError(resp.StatusCode, body)
+	}
+
+	if _, err := io.Copy(dest, resp.Body); err != nil {
+		return fmt.Errorf("error downloading file: %w", err)
+	}
+
+	return nil
+}
+
+// uploadTarget is where Canvas asks for an uploaded file to be sent
+type uploadTarget struct {
+	UploadURL    string            `json:"upload_url"`
+	UploadParams map[string]string `json:"upload_params"`
+}
+
+// UploadFile uploads a local file to a course folder, or to the course's
+// default upload folder when folderID is empty. It follows Canvas's upload
+// protocol: request an upload URL, send the file to it, then confirm the
+// upload if Canvas redirects to a confirmation URL. Under DryRun there is no
+// upload URL, so it stops after describing the file and returns nil.
+func (c *Client) UploadFile(courseID, folderID, localPath string) (*File, error) {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 1: tell Canvas about the file and get the upload URL
+	path := fmt.Sprintf("/courses/%s/files", courseID)
+	requestBody := map[string]interface{}{
+		"name":         filepath.Base(localPath),
+		"size":         info.Size(),
+		"on_duplicate": "rename",
+	}
+	if folderID != "" {
+		requestBody["parent_folder_id"] = folderID
+	}
+
+	data, err := c.RequestWithBody(c.context(), "POST", path, nil, requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("error starting upload: %w", err)
+	}
+	if DryRun {
+		fmt.Fprintf(os.Stderr, "DRY RUN: would upload %s (%d bytes)\n", localPath, info.Size())
+		return nil, nil
+	}
+
+	var target uploadTarget
+	if err := json.Unmarshal(data, &target); err != nil {
+		return nil, fmt.Errorf("error parsing upload response: %w", err)
+	}
+
+	// Step 2: send the file as multipart form data, with the upload params
+	// first and the file last. The body is streamed with a known length since
+	// some storage services reject chunked uploads.
+	var head bytes.Buffer
+	writer := multipart.NewWriter(&head)
+	for key, value := range target.UploadParams {
+		if err := writer.WriteField(key, value); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := writer.CreateFormFile("file", filepath.Base(localPath)); err != nil {
+		return nil, err
+	}
+	tail := "\r\n--" + writer.Boundary() + "--\r\n"
+
+	req, err := http.NewRequestWithContext(c.context(), "POST", target.UploadURL,
+		io.MultiReader(&head, f, bytes.NewBufferString(tail)))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.ContentLength = int64(head.Len()) + info.Size() + int64(len(tail))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	// Handle redirects ourselves so the confirmation request carries the API key
+	uploadClient := *c.HTTPClient
+	uploadClient.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	resp, err := uploadClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error uploading file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading upload response: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp.StatusCode, body)
+	}
+
+	// Step 3: confirm the upload when Canvas redirects to a confirmation URL
+	if location := resp.Header.Get("Location"); location != "" && (resp.StatusCode >= 300 || len(body) == 0) {
+		confirmPath := location
+		if u, err := url.Parse(location); err == nil {
+			confirmPath = u.Path
+		}
+		body, _, err = c.send(c.context(), "GET", location, confirmPath, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error confirming upload: %w", err)
+		}
+	}
+
+	var file File
+	if err := json.Unmarshal(body, &file); err != nil {
+		return nil, fmt.Errorf("error parsing uploaded file: %w", err)
+	}
+
+	return &file, nil
+}
@@ -1,6 +1,10 @@
 package api
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+)
 
 // Course represents a Canvas course
 type Course struct {
@@ -15,26 +19,116 @@ type Course struct {
 	GradingStandardID   int       `json:"grading_standard_id"`
 	CreatedAt           time.Time `json:"created_at"`
 	RestrictEnrollments bool      `json:"restrict_enrollments_to_course_dates"`
+	IsFavorite          bool      `json:"is_favorite"`
+	SISCourseID         string    `json:"sis_course_id"`
+	Term                *Term     `json:"term"` // Only set when requested with include[]=term
+}
+
+// Term represents a Canvas enrollment term, such as a semester
+type Term struct {
+	ID      int       `json:"id"`
+	Name    string    `json:"name"`
+	StartAt time.Time `json:"start_at"`
+	EndAt   time.Time `json:"end_at"`
+}
+
+// ContentMigration represents a Canvas content migration, such as a course copy
+type ContentMigration struct {
+	ID            int       `json:"id"`
+	MigrationType string    `json:"migration_type"`
+	WorkflowState string    `json:"workflow_state"`
+	StartedAt     time.Time `json:"started_at"`
+	FinishedAt    time.Time `json:"finished_at"`
+
+	// CourseID is the course the content is migrated into. Canvas does not
+	// return it, so it is set by the client.
+	CourseID int `json:"-"`
 }
 
 // Assignment represents a Canvas assignment
 type Assignment struct {
-	ID                 int       `json:"id"`
-	Name               string    `json:"name"`
-	Description        string    `json:"description"`
-	CreatedAt          time.Time `json:"created_at"`
-	UpdatedAt          time.Time `json:"updated_at"`
-	DueAt              time.Time `json:"due_at"`
-	LockAt             time.Time `json:"lock_at"`
-	UnlockAt           time.Time `json:"unlock_at"`
-	CourseID           int       `json:"course_id"`
-	PointsPossible     float64   `json:"points_possible"`
-	GradingType        string    `json:"grading_type"`
-	SubmissionTypes    []string  `json:"submission_types"`
-	Published          bool      `json:"published"`
-	HTMLURL            string    `json:"html_url"`
-	SubmissionsURL     string    `json:"submissions_download_url"`
-	GradeGroupStudents bool      `json:"grade_group_students_individually"`
+	ID                 int               `json:"id"`
+	Name               string            `json:"name"`
+	Description        string            `json:"description"`
+	CreatedAt          time.Time         `json:"created_at"`
+	UpdatedAt          time.Time         `json:"updated_at"`
+	DueAt              time.Time         `json:"due_at"`
+	LockAt             time.Time         `json:"lock_at"`
+	UnlockAt           time.Time         `json:"unlock_at"`
+	CourseID           int               `json:"course_id"`
+	AssignmentGroupID  int               `json:"assignment_group_id"`
+	PointsPossible     float64           `json:"points_possible"`
+	GradingType        string            `json:"grading_type"`
+	SubmissionTypes    []string          `json:"submission_types"`
+	Published          bool              `json:"published"`
+	HTMLURL            string            `json:"html_url"`
+	SubmissionsURL     string            `json:"submissions_download_url"`
+	GradeGroupStudents bool              `json:"grade_group_students_individually"`
+	GradingStandardID  int               `json:"grading_standard_id"` // 0 when the course's default scale is used
+	Rubric             []RubricCriterion `json:"rubric"`
+}
+
+// Rubric represents a Canvas rubric and its criteria
+type Rubric struct {
+	ID             int               `json:"id"`
+	Title          string            `json:"title"`
+	ContextID      int               `json:"context_id"`
+	ContextType    string            `json:"context_type"`
+	PointsPossible float64           `json:"points_possible"`
+	FreeForm       bool              `json:"free_form_criterion_comments"`
+	Criteria       []RubricCriterion `json:"data"`
+}
+
+// AssignmentOverride represents due dates that apply to some students or a
+// section instead of the assignment's default dates
+type AssignmentOverride struct {
+	ID              int       `json:"id"`
+	AssignmentID    int       `json:"assignment_id"`
+	Title           string    `json:"title"`
+	StudentIDs      []int     `json:"student_ids"`
+	CourseSectionID int       `json:"course_section_id"`
+	DueAt           time.Time `json:"due_at"`
+	UnlockAt        time.Time `json:"unlock_at"`
+	LockAt          time.Time `json:"lock_at"`
+}
+
+// AssignmentGroup represents a group of assignments, weighted together in
+// the course grade when the course uses weighted grading
+type AssignmentGroup struct {
+	ID          int     `json:"id"`
+	Name        string  `json:"name"`
+	Position    int     `json:"position"`
+	GroupWeight float64 `json:"group_weight"`
+	Rules       struct {
+		DropLowest  int   `json:"drop_lowest"`
+		DropHighest int   `json:"drop_highest"`
+		NeverDrop   []int `json:"never_drop"`
+	} `json:"rules"`
+	Assignments []Assignment `json:"assignments"` // Only set when requested with include[]=assignments
+}
+
+// RubricCriterion represents a single criterion of a rubric
+type RubricCriterion struct {
+	ID              string         `json:"id"`
+	Description     string         `json:"description"`
+	LongDescription string         `json:"long_description"`
+	Points          float64        `json:"points"`
+	Ratings         []RubricRating `json:"ratings"`
+}
+
+// RubricRating represents one rating level of a rubric criterion
+type RubricRating struct {
+	ID              string  `json:"id"`
+	Description     string  `json:"description"`
+	LongDescription string  `json:"long_description"`
+	Points          float64 `json:"points"`
+}
+
+// RubricAssessment represents the assessment of a single rubric criterion
+type RubricAssessment struct {
+	RatingID string  `json:"rating_id,omitempty"`
+	Points   float64 `json:"points"`
+	Comments string  `json:"comments,omitempty"`
 }
 
 // User represents a Canvas user
@@ -52,24 +146,98 @@ type User struct {
 	Avatar        string `json:"avatar_url"`
 }
 
+// UserProfile represents a Canvas user profile
+type UserProfile struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	ShortName    string `json:"short_name"`
+	SortableName string `json:"sortable_name"`
+	PrimaryEmail string `json:"primary_email"`
+	LoginID      string `json:"login_id"`
+	AvatarURL    string `json:"avatar_url"`
+	Bio          string `json:"bio"`
+	TimeZone     string `json:"time_zone"`
+}
+
 // Submission represents a Canvas assignment submission
 type Submission struct {
-	ID              int       `json:"id"`
-	AssignmentID    int       `json:"assignment_id"`
-	UserID          int       `json:"user_id"`
-	SubmittedAt     time.Time `json:"submitted_at"`
-	Score           float64   `json:"score"`
-	Grade           string    `json:"grade"`
-	AttemptNumber   int       `json:"attempt"`
-	Body            string    `json:"body"`
-	URL             string    `json:"url"`
-	GradedAt        time.Time `json:"graded_at"`
-	GraderID        int       `json:"grader_id"`
-	Late            bool      `json:"late"`
-	Missing         bool      `json:"missing"`
-	SubmissionType  string    `json:"submission_type"`
-	PreviewURL      string    `json:"preview_url"`
-	GradeMatchesHub bool      `json:"grade_matches_current_submission"`
+	ID              int                 `json:"id"`
+	AssignmentID    int                 `json:"assignment_id"`
+	UserID          int                 `json:"user_id"`
+	SubmittedAt     time.Time           `json:"submitted_at"`
+	Score           float64             `json:"score"`
+	Grade           string              `json:"grade"`
+	AttemptNumber   int                 `json:"attempt"`
+	Body            string              `json:"body"`
+	URL             string              `json:"url"`
+	GradedAt        time.Time           `json:"graded_at"`
+	GraderID        int                 `json:"grader_id"`
+	Late            bool                `json:"late"`
+	Missing         bool                `json:"missing"`
+	SubmissionType  string              `json:"submission_type"`
+	PreviewURL      string              `json:"preview_url"`
+	GradeMatchesHub bool                `json:"grade_matches_current_submission"`
+	WorkflowState   string              `json:"workflow_state"`
+	Excused         bool                `json:"excused"`
+	User            User                `json:"user"`
+	Assignment      *Assignment         `json:"assignment"` // Only set when requested with include[]=assignment
+	Comments        []SubmissionComment `json:"submission_comments"`
+}
+
+// SubmissionComment represents a comment left on a submission
+type SubmissionComment struct {
+	ID         int       `json:"id"`
+	AuthorID   int       `json:"author_id"`
+	AuthorName string    `json:"author_name"`
+	Comment    string    `json:"comment"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// SubmissionSummary represents the grading status counts for an assignment
+type SubmissionSummary struct {
+	Graded       int `json:"graded"`
+	Ungraded     int `json:"ungraded"`
+	NotSubmitted int `json:"not_submitted"`
+}
+
+// Section represents a Canvas course section
+type Section struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	CourseID     int       `json:"course_id"`
+	SISSourceID  string    `json:"sis_section_id"`
+	StudentCount int       `json:"total_students"`
+	StartAt      time.Time `json:"start_at"`
+	EndAt        time.Time `json:"end_at"`
+}
+
+// Group represents a Canvas group of users within a course
+type Group struct {
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	GroupCategoryID int    `json:"group_category_id"`
+	MembersCount    int    `json:"members_count"`
+	JoinLevel       string `json:"join_level"`
+	ContextType     string `json:"context_type"`
+	CourseID        int    `json:"course_id"`
+}
+
+// GroupCategory represents a Canvas group set, which groups belong to
+type GroupCategory struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Role      string `json:"role"`
+	GroupsURL string `json:"groups_url"`
+}
+
+// GroupMembership represents a user's membership in a group
+type GroupMembership struct {
+	ID            int    `json:"id"`
+	GroupID       int    `json:"group_id"`
+	UserID        int    `json:"user_id"`
+	WorkflowState string `json:"workflow_state"`
+	Moderator     bool   `json:"moderator"`
 }
 
 // Enrollment represents a Canvas enrollment (user enrollment in a course)
@@ -99,3 +267,252 @@ type Enrollment struct {
 	Role            string `json:"role"`
 	RoleID          int    `json:"role_id"`
 }
+
+// Page represents a Canvas wiki page
+type Page struct {
+	PageID       int       `json:"page_id"`
+	URL          string    `json:"url"`
+	Title        string    `json:"title"`
+	Body         string    `json:"body"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Published    bool      `json:"published"`
+	FrontPage    bool      `json:"front_page"`
+	HTMLURL      string    `json:"html_url"`
+	LastEditedBy struct {
+		ID          int    `json:"id"`
+		DisplayName string `json:"display_name"`
+	} `json:"last_edited_by"`
+}
+
+// File represents a file stored in Canvas
+type File struct {
+	ID          int       `json:"id"`
+	FolderID    int       `json:"folder_id"`
+	DisplayName string    `json:"display_name"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content-type"`
+	URL         string    `json:"url"` // Download URL
+	Size        int       `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Locked      bool      `json:"locked"`
+	Hidden      bool      `json:"hidden"`
+}
+
+// Folder represents a folder of files in Canvas
+type Folder struct {
+	ID             int       `json:"id"`
+	Name           string    `json:"name"`
+	FullName       string    `json:"full_name"`
+	ParentFolderID int       `json:"parent_folder_id"`
+	FilesCount     int       `json:"files_count"`
+	FoldersCount   int       `json:"folders_count"`
+	CreatedAt      time.Time `json:"created_at"`
+	Locked         bool      `json:"locked"`
+	Hidden         bool      `json:"hidden"`
+}
+
+// Module represents a Canvas course module
+type Module struct {
+	ID            int       `json:"id"`
+	Name          string    `json:"name"`
+	Position      int       `json:"position"`
+	WorkflowState string    `json:"workflow_state"`
+	ItemCount     int       `json:"items_count"`
+	ItemsURL      string    `json:"items_url"`
+	UnlockAt      time.Time `json:"unlock_at"`
+	Published     bool      `json:"published"`
+}
+
+// ModuleItem represents an item within a Canvas module
+type ModuleItem struct {
+	ID                    int    `json:"id"`
+	ModuleID              int    `json:"module_id"`
+	Position              int    `json:"position"`
+	Title                 string `json:"title"`
+	Type                  string `json:"type"`
+	ContentID             int    `json:"content_id"`
+	HTMLURL               string `json:"html_url"`
+	URL                   string `json:"url"`
+	Published             bool   `json:"published"`
+	CompletionRequirement struct {
+		Type      string  `json:"type"`
+		MinScore  float64 `json:"min_score"`
+		Completed bool    `json:"completed"`
+	} `json:"completion_requirement"`
+}
+
+// DiscussionTopic represents a Canvas discussion topic
+type DiscussionTopic struct {
+	ID                      int       `json:"id"`
+	Title                   string    `json:"title"`
+	Message                 string    `json:"message"`
+	HTMLURL                 string    `json:"html_url"`
+	PostedAt                time.Time `json:"posted_at"`
+	LastReplyAt             time.Time `json:"last_reply_at"`
+	UserName                string    `json:"user_name"`
+	DiscussionSubentryCount int       `json:"discussion_subentry_count"`
+	UnreadCount             int       `json:"unread_count"`
+	Subscribed              bool      `json:"subscribed"`
+	Published               bool      `json:"published"`
+	Locked                  bool      `json:"locked"`
+	Pinned                  bool      `json:"pinned"`
+}
+
+// Announcement represents a Canvas course announcement
+type Announcement struct {
+	ID          int       `json:"id"`
+	Title       string    `json:"title"`
+	Message     string    `json:"message"`
+	HTMLURL     string    `json:"html_url"`
+	PostedAt    time.Time `json:"posted_at"`
+	UserName    string    `json:"user_name"`
+	ContextCode string    `json:"context_code"`
+	Published   bool      `json:"published"`
+	ReadState   string    `json:"read_state"` // "read" or "unread" for the current user
+}
+
+// DiscussionEntry represents a post in a discussion topic and its replies
+type DiscussionEntry struct {
+	ID        int               `json:"id"`
+	UserID    int               `json:"user_id"`
+	UserName  string            `json:"user_name"`
+	ParentID  int               `json:"parent_id"`
+	Message   string            `json:"message"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+	Deleted   bool              `json:"deleted"`
+	Replies   []DiscussionEntry `json:"replies"`
+}
+
+// Quiz represents a Canvas classic quiz
+type Quiz struct {
+	ID              int       `json:"id"`
+	Title           string    `json:"title"`
+	Description     string    `json:"description"`
+	QuizType        string    `json:"quiz_type"`
+	TimeLimit       int       `json:"time_limit"`
+	PointsPossible  float64   `json:"points_possible"`
+	Published       bool      `json:"published"`
+	AllowedAttempts int       `json:"allowed_attempts"`
+	ShuffleAnswers  bool      `json:"shuffle_answers"`
+	QuestionCount   int       `json:"question_count"`
+	DueAt           time.Time `json:"due_at"`
+	UnlockAt        time.Time `json:"unlock_at"`
+	LockAt          time.Time `json:"lock_at"`
+	HTMLURL         string    `json:"html_url"`
+}
+
+// GradingStandard represents a grading scale that maps scores to letter
+// grades or GPA values
+type GradingStandard struct {
+	ID            int                  `json:"id"`
+	Title         string               `json:"title"`
+	ContextType   string               `json:"context_type"` // "Course" or "Account"
+	ContextID     int                  `json:"context_id"`
+	PointsBased   bool                 `json:"points_based"`
+	ScalingFactor float64              `json:"scaling_factor"` // Points of the top of a points-based scale
+	GradingScheme []GradingSchemeEntry `json:"grading_scheme"`
+}
+
+// GradingSchemeEntry is one grade of a grading standard
+type GradingSchemeEntry struct {
+	Name  string  `json:"name"`
+	Value float64 `json:"value"` // Minimum score for the grade, as a fraction from 0 to 1
+}
+
+// GradingPeriod represents a period, such as a quarter or semester, that a
+// course's grades are divided into
+type GradingPeriod struct {
+	ID        int       `json:"id"`
+	Title     string    `json:"title"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+	CloseDate time.Time `json:"close_date"`
+	Weight    float64   `json:"weight"`
+	IsClosed  bool      `json:"is_closed"`
+}
+
+// LatePolicy represents a course's automatic deductions for late and missing
+// submissions. Deductions are percentages of the points possible.
+type LatePolicy struct {
+	ID                                  int     `json:"id,omitempty"`
+	CourseID                            int     `json:"course_id,omitempty"`
+	MissingSubmissionDeductionEnabled   bool    `json:"missing_submission_deduction_enabled"`
+	MissingSubmissionDeduction          float64 `json:"missing_submission_deduction"`
+	LateSubmissionDeductionEnabled      bool    `json:"late_submission_deduction_enabled"`
+	LateSubmissionDeduction             float64 `json:"late_submission_deduction"` // Per interval
+	LateSubmissionInterval              string  `json:"late_submission_interval"`  // "day" or "hour"
+	LateSubmissionMinimumPercentEnabled bool    `json:"late_submission_minimum_percent_enabled"`
+	LateSubmissionMinimumPercent        float64 `json:"late_submission_minimum_percent"`
+}
+
+// APIError represents an error response from the Canvas API
+type APIError struct {
+	StatusCode int
+	Errors     []APIErrorMessage
+	Body       string // Raw response body, used when it holds no error messages
+}
+
+// APIErrorMessage is a single error message in a Canvas error response.
+// Attribute is set for validation errors on a specific field.
+type APIErrorMessage struct {
+	Attribute string `json:"attribute"`
+	Type      string `json:"type"`
+	Message   string `json:"message"`
+}
+
+// String formats the message, prefixed by its attribute for validation errors
+func (m APIErrorMessage) String() string {
+	message := m.Message
+	if message == "" || message == m.Type {
+		// Validation errors often only carry a type such as "blank"
+		switch m.Type {
+		case "blank":
+			message = "can't be blank"
+		case "too_long":
+			message = "is too long"
+		case "invalid":
+			message = "is invalid"
+		case "taken":
+			message = "has already been taken"
+		default:
+			message = strings.ReplaceAll(m.Type, "_", " ")
+		}
+	}
+
+	if m.Attribute != "" {
+		return strings.ReplaceAll(m.Attribute, "_", " ") + " " + message
+	}
+	return message
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("API error %d: %s", e.StatusCode, e.Body)
+	}
+
+	messages := make([]string, len(e.Errors))
+	for i, message := range e.Errors {
+		messages[i] = message.String()
+	}
+	return fmt.Sprintf("API error %d: %s", e.StatusCode, strings.Join(messages, "; "))
+}
+
+// CourseError is the error of a request for one course among many
+type CourseError struct {
+	CourseID string
+	Err      error
+}
+
+// Error implements the error interface
+func (e *CourseError) Error() string {
+	return fmt.Sprintf("course %s: %v", e.CourseID, e.Err)
+}
+
+// Unwrap returns the error of the request
+func (e *CourseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,104 @@
+package api
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RequestRecord describes a single API request made by a Client
+type RequestRecord struct {
+	Method     string
+	Path       string
+	Duration   time.Duration
+	StatusCode int // 0 when no response was received
+	Bytes      int
+}
+
+// Failed reports whether the request failed or returned an error status
+func (r RequestRecord) Failed() bool {
+	return r.StatusCode == 0 || r.StatusCode >= 400
+}
+
+// RequestLog collects the API requests made during the current session
+type RequestLog struct {
+	mu      sync.Mutex
+	records []RequestRecord
+}
+
+// DefaultRequestLog records every request made by any Client
+var DefaultRequestLog = &RequestLog{}
+
+// Record adds a request to the log
+func (l *RequestLog) Record(record RequestRecord) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.records = append(l.records, record)
+}
+
+// Records returns a copy of the logged requests
+func (l *RequestLog) Records() []RequestRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	records := make([]RequestRecord, len(l.records))
+	copy(records, l.records)
+	return records
+}
+
+// RequestStats summarizes the requests in a RequestLog
+type RequestStats struct {
+	Total           int
+	Bytes           int
+	Errors          int
+	Slowest         RequestRecord
+	MostCalled      string // Endpoint pattern, such as "GET /courses/:id/users"
+	MostCalledCount int
+}
+
+// ErrorRate returns the share of requests that failed, from 0 to 1
+func (s RequestStats) ErrorRate() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Total)
+}
+
+// Stats summarizes the logged requests
+func (l *RequestLog) Stats() RequestStats {
+	var stats RequestStats
+	counts := make(map[string]int)
+
+	for _, record := range l.Records() {
+		stats.Total++
+		stats.Bytes += record.Bytes
+		if record.Failed() {
+			stats.Errors++
+		}
+		if record.Duration > stats.Slowest.Duration {
+			stats.Slowest = record
+		}
+
+		endpoint := record.Method + " " + endpointPattern(record.Path)
+		counts[endpoint]++
+		if counts[endpoint] > stats.MostCalledCount ||
+			(counts[endpoint] == stats.MostCalledCount && endpoint < stats.MostCalled) {
+			stats.MostCalled = endpoint
+			stats.MostCalledCount = counts[endpoint]
+		}
+	}
+
+	return stats
+}
+
+// endpointPattern replaces numeric path segments with ":id" so requests to
+// the same endpoint for different objects are counted together
+func endpointPattern(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if _, err := strconv.Atoi(segment); err == nil {
+			segments[i] = ":id"
+		}
+	}
+	return strings.Join(segments, "/")
+}
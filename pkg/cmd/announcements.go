@@ -0,0 +1,226 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// NewAnnouncementsCmd creates a new command for managing announcements
+func NewAnnouncementsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "announcements",
+		Short: "Manage Canvas announcements",
+		Long:  `List, view, and create announcements in Canvas courses.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newAnnouncementsListCmd(),
+		newAnnouncementsViewCmd(),
+		newAnnouncementsCreateCmd(),
+	)
+
+	return cmd
+}
+
+func newAnnouncementsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [course-id]",
+		Short: "List announcements in a course",
+		Long:  `List the announcements of a Canvas course, newest first.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runAnnouncementsList(args[0])
+		},
+	}
+}
+
+func newAnnouncementsViewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "view [course-id] [announcement-id]",
+		Short: "View an announcement",
+		Long:  `View the full text of a Canvas announcement.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runAnnouncementsView(args[0], args[1])
+		},
+	}
+}
+
+func newAnnouncementsCreateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "create [course-id]",
+		Short: "Create an announcement",
+		Long:  `Post a new announcement to a Canvas course with interactive form input.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runAnnouncementsCreate(args[0])
+		},
+	}
+}
+
+func runAnnouncementsList(courseID string) {
+	client := newClient()
+	announcements, err := client.GetAnnouncements(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching announcements: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, announcement := range announcements {
+		posted := ""
+		if !announcement.PostedAt.IsZero() {
+			posted = announcement.PostedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
+		}
+
+		rows = append(rows, table.Row{
+			strconv.Itoa(announcement.ID),
+			announcement.Title,
+			announcement.UserName,
+			posted,
+		})
+	}
+
+	// Create a table for announcements
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Title", Width: 40},
+		{Title: "Author", Width: 20},
+		{Title: "Posted", Width: 20},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Announcements for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+	m.EnableFilter(1)
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runAnnouncementsView(courseID, announcementID string) {
+	client := newClient()
+	announcement, err := client.GetAnnouncement(courseID, announcementID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching announcement: %v\n", err)
+		return
+	}
+
+	render := func(width int) string {
+		return formatAnnouncement(announcement, width)
+	}
+
+	// Print plain text when the output is piped
+	if !stdoutIsTerminal() {
+		fmt.Println(render(80))
+		return
+	}
+
+	model := ui.NewViewportModel("Announcement", render)
+	if _, err := runProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running announcement view: %v\n", err)
+	}
+}
+
+// formatAnnouncement formats an announcement with its plain-text body
+func formatAnnouncement(announcement *api.Announcement, width int) string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("205")).
+		Bold(true)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("39")).
+		Bold(true).
+		Width(9)
+
+	posted := "Not set"
+	if !announcement.PostedAt.IsZero() {
+		posted = announcement.PostedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
+	}
+
+	var content strings.Builder
+	content.WriteString(titleStyle.Render(announcement.Title) + "\n\n")
+	content.WriteString(labelStyle.Render("Author:") + announcement.UserName + "\n")
+	content.WriteString(labelStyle.Render("Posted:") + posted + "\n\n")
+	content.WriteString(lipgloss.NewStyle().Width(width-6).Render(stripHTML(announcement.Message)) + "\n")
+
+	return content.String()
+}
+
+func runAnnouncementsCreate(courseID string) {
+	var title, message string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Title").
+				Prompt("> ").
+				Placeholder("Enter announcement title").
+				Validate(func(s string) error {
+					if strings.TrimSpace(s) == "" {
+						return fmt.Errorf("title is required")
+					}
+					return nil
+				}).
+				Value(&title),
+
+			huh.NewText().
+				Title("Message").
+				Placeholder("Enter announcement message").
+				Editor("vi").
+				CharLimit(10000).
+				Validate(func(s string) error {
+					if strings.TrimSpace(s) == "" {
+						return fmt.Errorf("message is required")
+					}
+					return nil
+				}).
+				Value(&message),
+		),
+	).WithTheme(huh.ThemeBase16())
+
+	if err := form.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	client := newClient()
+	announcement, err := client.CreateAnnouncement(courseID, title, message)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating announcement: %v\n", err)
+		return
+	}
+
+	fmt.Println("\n✅ Announcement posted successfully!")
+	fmt.Printf("ID: %d\n", announcement.ID)
+	fmt.Printf("Title: %s\n", announcement.Title)
+}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+)
+
+func newAssignmentGroupsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups",
+		Short: "Manage assignment groups",
+		Long:  `List and create assignment groups, which are weighted together in the course grade when weighted grading is enabled.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(
+		newAssignmentGroupsListCmd(),
+		newAssignmentGroupsCreateCmd(),
+	)
+
+	return cmd
+}
+
+func newAssignmentGroupsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [course-id]",
+		Short: "List assignment groups in a course",
+		Long:  `List the assignment groups of a Canvas course with their weights.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runAssignmentGroupsList(args[0])
+		},
+	}
+}
+
+func newAssignmentGroupsCreateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "create [course-id]",
+		Short: "Create an assignment group",
+		Long:  `Create a new assignment group in a Canvas course with interactive form input.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runAssignmentGroupsCreate(args[0])
+		},
+	}
+}
+
+func runAssignmentGroupsList(courseID string) {
+	client := newClient()
+	groups, err := client.GetAssignmentGroups(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching assignment groups: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, group := range groups {
+		rows = append(rows, table.Row{
+			strconv.Itoa(group.ID),
+			group.Name,
+			strconv.FormatFloat(group.GroupWeight, 'f', -1, 64) + "%",
+			strconv.Itoa(len(group.Assignments)),
+		})
+	}
+
+	// Create a table for assignment groups
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Name", Width: 30},
+		{Title: "Weight", Width: 8},
+		{Title: "Assignments", Width: 12},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Assignment Groups for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runAssignmentGroupsCreate(courseID string) {
+	var name string
+	var weight string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Name").
+				Prompt("> ").
+				Placeholder("Enter group name (e.g. Homework)").
+				Validate(func(s string) error {
+					if s == "" {
+						return fmt.Errorf("name is required")
+					}
+					return nil
+				}).
+				Value(&name),
+
+			huh.NewInput().
+				Title("Weight").
+				Prompt("> ").
+				Placeholder("Percent of the course grade (e.g. 25)").
+				Validate(func(s string) error {
+					if s == "" {
+						return nil
+					}
+					val, err := strconv.ParseFloat(s, 64)
+					if err != nil {
+						return fmt.Errorf("weight must be a number")
+					}
+					if val < 0 || val > 100 {
+						return fmt.Errorf("weight must be between 0 and 100")
+					}
+					return nil
+				}).
+				Value(&weight),
+		),
+	).WithTheme(huh.ThemeBase16())
+
+	if err := form.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	// Weight was validated by the form, so an empty value is the only failure
+	groupWeight, _ := strconv.ParseFloat(weight, 64)
+
+	client := newClient()
+	group, err := client.CreateAssignmentGroup(courseID, name, groupWeight)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating assignment group: %v\n", err)
+		return
+	}
+
+	fmt.Println("\n✅ Assignment group created successfully!")
+	fmt.Printf("ID: %d\n", group.ID)
+	fmt.Printf("Name: %s\n", group.Name)
+	fmt.Printf("Weight: %s%%\n", strconv.FormatFloat(group.GroupWeight, 'f', -1, 64))
+}
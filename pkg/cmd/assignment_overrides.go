@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+)
+
+func newAssignmentOverridesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "overrides",
+		Short: "Manage assignment due date overrides",
+		Long:  `List and add due dates that apply to individual students or a section instead of the assignment's default dates.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(
+		newAssignmentOverridesListCmd(),
+		newAssignmentOverridesAddCmd(),
+	)
+
+	return cmd
+}
+
+func newAssignmentOverridesListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [course-id] [assignment-id]",
+		Short: "List the overrides of an assignment",
+		Long:  `List the due date overrides of an assignment and who they apply to.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runAssignmentOverridesList(args[0], args[1])
+		},
+	}
+}
+
+func newAssignmentOverridesAddCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add [course-id] [assignment-id]",
+		Short: "Add an assignment override",
+		Long:  `Give students or a section their own due, unlock, and lock dates for an assignment with interactive form input.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runAssignmentOverridesAdd(args[0], args[1])
+		},
+	}
+}
+
+func runAssignmentOverridesList(courseID, assignmentID string) {
+	client := newClient()
+	overrides, err := client.GetAssignmentOverrides(courseID, assignmentID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching assignment overrides: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, override := range overrides {
+		rows = append(rows, table.Row{
+			strconv.Itoa(override.ID),
+			override.Title,
+			formatOverrideDate(override.DueAt),
+			formatOverrideDate(override.UnlockAt),
+			formatOverrideDate(override.LockAt),
+		})
+	}
+
+	// Create a table for assignment overrides
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Applies To", Width: 30},
+		{Title: "Due", Width: 20},
+		{Title: "Unlock", Width: 20},
+		{Title: "Lock", Width: 20},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Overrides for Assignment %s", assignmentID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// formatOverrideDate formats an override date, which is unset when the
+// override keeps the assignment's date
+func formatOverrideDate(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
+}
+
+// parseStudentIDs parses a comma-separated list of user IDs
+func parseStudentIDs(s string) ([]int, error) {
+	var ids []int
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		id, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("invalid student ID %q", field)
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("at least one student ID is required")
+	}
+	return ids, nil
+}
+
+func runAssignmentOverridesAdd(courseID, assignmentID string) {
+	client := newClient()
+
+	sections, err := client.GetSections(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not fetch sections: %v\n", err)
+	}
+
+	target := "students"
+	var studentIDs, sectionID string
+	var dueAt, unlockAt, lockAt string
+
+	targetOptions := []huh.Option[string]{huh.NewOption("Individual students", "students")}
+	if len(sections) > 0 {
+		targetOptions = append(targetOptions, huh.NewOption("A section", "section"))
+	}
+
+	sectionOptions := []huh.Option[string]{}
+	for _, section := range sections {
+		sectionOptions = append(sectionOptions, huh.NewOption(section.Name, strconv.Itoa(section.ID)))
+	}
+	if len(sections) > 0 {
+		sectionID = strconv.Itoa(sections[0].ID)
+	}
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Applies To").
+				Options(targetOptions...).
+				Value(&target),
+		),
+
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Student IDs").
+				Prompt("> ").
+				Placeholder("Comma-separated user IDs (e.g. 101, 102)").
+				Validate(func(s string) error {
+					_, err := parseStudentIDs(s)
+					return err
+				}).
+				Value(&studentIDs),
+		).WithHideFunc(func() bool {
+			return target != "students"
+		}),
+
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Section").
+				Options(sectionOptions...).
+				Value(&sectionID),
+		).WithHideFunc(func() bool {
+			return target != "section"
+		}),
+
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Due Date").
+				Prompt("> ").
+				Placeholder("YYYY-MM-DD HH:MM (optional)").
+				Validate(validateAssignmentDate).
+				Value(&dueAt),
+
+			huh.NewInput().
+				Title("Unlock Date").
+				Prompt("> ").
+				Placeholder("YYYY-MM-DD HH:MM (optional)").
+				Validate(validateAssignmentDate).
+				Value(&unlockAt),
+
+			huh.NewInput().
+				Title("Lock Date").
+				Prompt("> ").
+				Placeholder("YYYY-MM-DD HH:MM (optional)").
+				Validate(validateAssignmentDate).
+				Value(&lockAt),
+		),
+	).WithTheme(huh.ThemeBase16())
+
+	if err := form.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	override := &api.AssignmentOverride{}
+	if target == "students" {
+		// Student IDs were validated by the form
+		override.StudentIDs, _ = parseStudentIDs(studentIDs)
+	} else {
+		override.CourseSectionID, _ = strconv.Atoi(sectionID)
+	}
+
+	// Dates were validated by the form, so empty values are the only failures
+	override.DueAt, _ = time.ParseInLocation(assignmentDateLayout, dueAt, config.Location())
+	override.UnlockAt, _ = time.ParseInLocation(assignmentDateLayout, unlockAt, config.Location())
+	override.LockAt, _ = time.ParseInLocation(assignmentDateLayout, lockAt, config.Location())
+
+	created, err := client.CreateAssignmentOverride(courseID, assignmentID, override)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating assignment override: %v\n", err)
+		return
+	}
+
+	fmt.Println("\n✅ Assignment override created successfully!")
+	fmt.Printf("ID: %d\n", created.ID)
+	fmt.Printf("Applies To: %s\n", created.Title)
+	fmt.Printf("Due: %s\n", formatOverrideDate(created.DueAt))
+}
@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// templateExt is the extension of saved assignment templates
+const templateExt = ".yaml"
+
+func newAssignmentTemplatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "templates",
+		Short: "Save and reuse assignment settings",
+		Long: `Save the settings of the last assignment you created as a named template,
+then create new assignments with the form pre-filled from it.
+
+Templates are YAML files in the assignment_templates directory next to the
+config file, so they can also be edited by hand.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(
+		newAssignmentTemplatesSaveCmd(),
+		newAssignmentTemplatesListCmd(),
+		newAssignmentTemplatesUseCmd(),
+	)
+
+	return cmd
+}
+
+func newAssignmentTemplatesSaveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "save [name]",
+		Short: "Save the last created assignment as a template",
+		Long: `Save the settings of the last assignment created with "assignments add" or
+"assignments templates use" as a template, replacing any template with the
+same name.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runAssignmentTemplatesSave(args[0])
+		},
+	}
+}
+
+func newAssignmentTemplatesListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List saved assignment templates",
+		Long:  `List the saved assignment templates with their settings.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runAssignmentTemplatesList()
+		},
+	}
+}
+
+func newAssignmentTemplatesUseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use [template-name] [course-id]",
+		Short: "Create an assignment from a template",
+		Long: `Create a new assignment in a Canvas course with the interactive form
+pre-filled from a saved template.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runAssignmentTemplatesUse(args[0], args[1])
+		},
+	}
+	cmd.ValidArgsFunction = completeTemplateNamesAndCourseIDs
+
+	return cmd
+}
+
+// completeTemplateNamesAndCourseIDs completes a template name, then a course ID
+func completeTemplateNamesAndCourseIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return completeCourseIDs(cmd, args[1:], toComplete)
+	}
+
+	names, err := assignmentTemplateNames()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// assignmentTemplatesDir returns the directory holding saved templates
+func assignmentTemplatesDir() string {
+	return filepath.Join(config.ActiveDir(), "assignment_templates")
+}
+
+// lastAssignmentPath returns the file holding the settings of the last
+// created assignment. It is kept outside the templates directory so it
+// isn't listed as a template.
+func lastAssignmentPath() string {
+	return filepath.Join(config.ActiveDir(), "last_assignment"+templateExt)
+}
+
+// assignmentTemplatePath returns the file of the template called name
+func assignmentTemplatePath(name string) (string, error) {
+	if name == "" || strings.HasPrefix(name, ".") || strings.ContainsAny(name, `/\`) {
+		return "", fmt.Errorf("invalid template name %q: names cannot be empty, start with a dot, or contain slashes", name)
+	}
+	return filepath.Join(assignmentTemplatesDir(), name+templateExt), nil
+}
+
+// assignmentTemplateNames returns the names of the saved templates, sorted
+func assignmentTemplateNames() ([]string, error) {
+	entries, err := os.ReadDir(assignmentTemplatesDir())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == templateExt {
+			names = append(names, strings.TrimSuffix(entry.Name(), templateExt))
+		}
+	}
+	return names, nil
+}
+
+func readAssignmentTemplate(path string) (AssignmentTemplate, error) {
+	var template AssignmentTemplate
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return template, err
+	}
+	if err := yaml.Unmarshal(data, &template); err != nil {
+		return template, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+	return template, nil
+}
+
+func writeAssignmentTemplate(path string, template AssignmentTemplate) error {
+	data, err := yaml.Marshal(template)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func runAssignmentTemplatesSave(name string) {
+	path, err := assignmentTemplatePath(name)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return
+	}
+
+	template, err := readAssignmentTemplate(lastAssignmentPath())
+	if errors.Is(err, fs.ErrNotExist) {
+		fmt.Fprintln(os.Stderr, "Error: no assignment has been created yet. Create one with \"canvas-cli assignments add\" first.")
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading the last assignment: %v\n", err)
+		return
+	}
+
+	if err := writeAssignmentTemplate(path, template); err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving template: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Successfully saved template '%s' from assignment '%s'\n", name, template.Name)
+}
+
+func runAssignmentTemplatesList() {
+	names, err := assignmentTemplateNames()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading templates: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, name := range names {
+		template, err := readAssignmentTemplate(filepath.Join(assignmentTemplatesDir(), name+templateExt))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not read template %s: %v\n", name, err)
+			continue
+		}
+
+		published := "No"
+		if template.Published {
+			published = "Yes"
+		}
+		rows = append(rows, table.Row{
+			name,
+			template.Name,
+			strconv.FormatFloat(template.PointsPossible, 'f', -1, 64),
+			template.GradingType,
+			strings.Join(template.SubmissionTypes, ", "),
+			published,
+		})
+	}
+
+	columns := []table.Column{
+		{Title: "Template", Width: 20},
+		{Title: "Assignment Name", Width: 30},
+		{Title: "Points", Width: 8},
+		{Title: "Grading Type", Width: 14},
+		{Title: "Submission Types", Width: 30},
+		{Title: "Published", Width: 10},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	if len(rows) == 0 {
+		fmt.Println("No assignment templates saved. Create an assignment, then save it with \"canvas-cli assignments templates save [name]\".")
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = "Assignment Templates"
+	m.Help = "↑/↓: Navigate • q: Quit"
+	m.EnableFilter(-1)
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runAssignmentTemplatesUse(name, courseID string) {
+	path, err := assignmentTemplatePath(name)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return
+	}
+
+	template, err := readAssignmentTemplate(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		fmt.Fprintf(os.Stderr, "Error: no template named '%s'. See saved templates with \"canvas-cli assignments templates list\".\n", name)
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading template: %v\n", err)
+		return
+	}
+
+	form := AssignmentForm(template)
+	if err := runAssignmentForm("Create New Assignment", fmt.Sprintf("Pre-filled from the '%s' template", name), &form); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	createAssignment(courseID, form)
+}
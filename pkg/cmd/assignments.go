@@ -1,19 +1,29 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"net/url"
 	"os"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ical"
 	"github.com/Reisender/canvas-cli-v2/pkg/ui"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/spf13/cobra"
 )
 
@@ -33,29 +43,90 @@ func NewAssignmentsCmd() *cobra.Command {
 		newAssignmentsListCmd(),
 		newAssignmentsViewCmd(),
 		newAssignmentsAddCmd(),
+		newAssignmentsEditCmd(),
+		newAssignmentsBulkPublishCmd(),
+		newAssignmentsSubmissionsSummaryCmd(),
+		newAssignmentsMissingCmd(),
+		newAssignmentsStatsCmd(),
+		newAssignmentsCalendarCmd(),
+		newAssignmentGroupsCmd(),
+		newAssignmentOverridesCmd(),
+		newAssignmentTemplatesCmd(),
 	)
 
 	return cmd
 }
 
 func newAssignmentsListCmd() *cobra.Command {
-	return &cobra.Command{
+	var upcomingWeek, overdue, ungraded, unsubmitted bool
+	var pagination paginationOptions
+	var watch time.Duration
+
+	cmd := &cobra.Command{
 		Use:   "list [course-id]",
 		Short: "List assignments for a course",
-		Long:  `List all assignments for a specific course in Canvas.`,
-		Args:  cobra.ExactArgs(1),
-		Run:   runAssignmentsList,
+		Long: `List all assignments for a specific course in Canvas.
+
+Use --upcoming-week, --overdue, --ungraded, or --unsubmitted to have Canvas
+only return assignments in that bucket.
+
+Results are fetched one page at a time; use --page and --per-page to choose
+the page, or --all to fetch every page.
+
+With --watch, the table is fetched again every 30 seconds, or at the interval
+given with --watch=10s, showing when it was last updated.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			// Resolve the bucket flags into a single Canvas bucket
+			buckets := map[string]bool{
+				"upcoming":    upcomingWeek,
+				"overdue":     overdue,
+				"ungraded":    ungraded,
+				"unsubmitted": unsubmitted,
+			}
+			bucket := ""
+			for name, set := range buckets {
+				if !set {
+					continue
+				}
+				if bucket != "" {
+					fmt.Fprintln(os.Stderr, "Error: only one of --upcoming-week, --overdue, --ungraded, and --unsubmitted can be used")
+					return
+				}
+				bucket = name
+			}
+
+			if err := pagination.validate(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return
+			}
+			runAssignmentsList(args[0], bucket, pagination, watch)
+		},
 	}
+
+	cmd.Flags().BoolVar(&upcomingWeek, "upcoming-week", false, "Only show assignments due in the next week")
+	addWatchFlag(cmd, &watch)
+	cmd.Flags().BoolVar(&overdue, "overdue", false, "Only show overdue assignments")
+	cmd.Flags().BoolVar(&ungraded, "ungraded", false, "Only show assignments with ungraded submissions")
+	cmd.Flags().BoolVar(&unsubmitted, "unsubmitted", false, "Only show assignments that have not been submitted")
+	addPaginationFlags(cmd, &pagination)
+	addBookmarkFlag(cmd, "course")
+	cmd.ValidArgsFunction = completeCourseIDs
+	return cmd
 }
 
 func newAssignmentsViewCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "view [course-id] [assignment-id]",
 		Short: "View a Canvas assignment",
 		Long:  `View details about a specific Canvas assignment.`,
 		Args:  cobra.ExactArgs(2),
 		Run:   runAssignmentsView,
 	}
+	cmd.ValidArgsFunction = completeCourseAndAssignmentIDs
+
+	addBookmarkFlag(cmd, "assignment")
+	return cmd
 }
 
 func newAssignmentsAddCmd() *cobra.Command {
@@ -68,6 +139,121 @@ func newAssignmentsAddCmd() *cobra.Command {
 	}
 }
 
+func newAssignmentsEditCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "edit [course-id] [assignment-id]",
+		Short: "Edit an existing assignment",
+		Long:  `Edit a Canvas assignment with an interactive form pre-populated with its current values.`,
+		Args:  cobra.ExactArgs(2),
+		Run:   runAssignmentsEdit,
+	}
+}
+
+func newAssignmentsBulkPublishCmd() *cobra.Command {
+	var published bool
+	var filterUnpublished bool
+
+	cmd := &cobra.Command{
+		Use:   "bulk-publish [course-id] --published=true|false",
+		Short: "Publish or unpublish every assignment in a course",
+		Long: `Publish or unpublish every assignment in a Canvas course, showing progress
+as each assignment is updated.
+
+Canvas does not allow unpublishing assignments that already have submissions;
+those are reported as failures.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if filterUnpublished && !published {
+				fmt.Fprintln(os.Stderr, "Error: --filter-unpublished can only be used with --published=true")
+				return
+			}
+			runAssignmentsBulkPublish(args[0], published, filterUnpublished)
+		},
+	}
+
+	cmd.Flags().BoolVar(&published, "published", false, "Whether the assignments should be published")
+	cmd.Flags().BoolVar(&filterUnpublished, "filter-unpublished", false, "Only publish assignments that are currently unpublished")
+	cmd.MarkFlagRequired("published")
+
+	return cmd
+}
+
+func newAssignmentsSubmissionsSummaryCmd() *cobra.Command {
+	var sortBy string
+	var filter string
+
+	cmd := &cobra.Command{
+		Use:   "submissions-summary [course-id]",
+		Short: "Show submission and grading status for every assignment",
+		Long: `Show a table of submission and grading counts for every assignment in a course.
+
+By default assignments are sorted by percentage graded so the ones most behind
+appear first. Use --sort to order by name, due, missing, or ungraded instead,
+and --filter to only show assignments that are ungraded, missing, or complete.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runAssignmentsSubmissionsSummary(args[0], sortBy, filter)
+		},
+	}
+
+	cmd.Flags().StringVar(&sortBy, "sort", "graded", "Sort by graded, name, due, missing, or ungraded")
+	cmd.Flags().StringVar(&filter, "filter", "", "Only show assignments that are ungraded, missing, or complete")
+
+	return cmd
+}
+
+func newAssignmentsMissingCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "missing [course-id]",
+		Short: "Show past-due work students have not submitted",
+		Long:  `Show every submission in a course that Canvas has marked missing, with the student and the assignment's due date, oldest first.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runAssignmentsMissing(args[0])
+		},
+	}
+}
+
+func newAssignmentsStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats [course-id] [assignment-id]",
+		Short: "Show the score distribution of an assignment",
+		Long: `Show the minimum, maximum, mean, median, and standard deviation of an
+assignment's graded scores, a histogram of the scores, and how many
+submissions are late, missing, or excused.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runAssignmentsStats(args[0], args[1])
+		},
+	}
+	cmd.ValidArgsFunction = completeCourseAndAssignmentIDs
+
+	return cmd
+}
+
+func newAssignmentsCalendarCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "calendar [course-id] [output-file]",
+		Short: "Export assignment due dates as an iCalendar file",
+		Long: `Export the due dates of a course's assignments to an iCalendar (.ics) file
+that can be imported into Google Calendar, Outlook, or Apple Calendar.
+Assignments without a due date are left out.
+
+Writes to stdout when no output file is given or the output file is "-".`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			outputFile := "-"
+			if len(args) > 1 {
+				outputFile = args[1]
+			}
+			runAssignmentsCalendar(args[0], outputFile)
+		},
+	}
+	cmd.ValidArgsFunction = completeCourseIDs
+
+	return cmd
+}
+
 // AssignmentForm represents the data collected from the form
 type AssignmentForm struct {
 	Name            string
@@ -81,6 +267,20 @@ type AssignmentForm struct {
 	Published       bool
 }
 
+// AssignmentTemplate is an AssignmentForm saved as YAML to reuse its settings.
+// Its fields must match AssignmentForm so one can be converted to the other.
+type AssignmentTemplate struct {
+	Name            string   `yaml:"name"`
+	Description     string   `yaml:"description,omitempty"`
+	PointsPossible  float64  `yaml:"points_possible"`
+	DueDate         string   `yaml:"due_date,omitempty"`
+	UnlockDate      string   `yaml:"unlock_date,omitempty"`
+	LockDate        string   `yaml:"lock_date,omitempty"`
+	GradingType     string   `yaml:"grading_type"`
+	SubmissionTypes []string `yaml:"submission_types"`
+	Published       bool     `yaml:"published"`
+}
+
 // AssignmentDetailModel represents a model for viewing assignment details
 type AssignmentDetailModel struct {
 	assignment   *api.Assignment
@@ -88,37 +288,139 @@ type AssignmentDetailModel struct {
 	ready        bool
 	width        int
 	height       int
-	courseID     string
-	assignmentID string
+	content      string // Formatted details without search highlights
+	searchInput  textinput.Model
+	searching    bool   // Whether the search input has focus
+	searchQuery  string // Text highlighted in the details
+	matches      []searchMatch
+	currentMatch int // Index into matches of the match n/N moved to
+}
+
+// searchMatch is the position of a search match in the formatted details, in
+// terminal cells
+type searchMatch struct {
+	line, start, end int
 }
 
 // Initialize the assignment detail model
-func NewAssignmentDetailModel(courseID, assignmentID string) AssignmentDetailModel {
+func NewAssignmentDetailModel(assignment *api.Assignment) AssignmentDetailModel {
+	searchInput := textinput.New()
+	searchInput.Prompt = "/"
+	searchInput.Placeholder = "search"
+	searchInput.PromptStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("170"))
+
 	return AssignmentDetailModel{
-		courseID:     courseID,
-		assignmentID: assignmentID,
+		assignment:  assignment,
+		searchInput: searchInput,
 	}
 }
 
-// Init initializes the assignment detail model
-func (m AssignmentDetailModel) Init() tea.Cmd {
-	return func() tea.Msg {
-		client := api.NewClient()
-		assignment, err := client.GetAssignment(m.courseID, m.assignmentID)
-		if err != nil {
-			return AssignmentDetailErrorMsg{err}
+// findMatches finds every case-insensitive match of searchQuery in content
+func (m *AssignmentDetailModel) findMatches() {
+	m.matches = nil
+	m.currentMatch = 0
+	if m.searchQuery == "" {
+		return
+	}
+
+	for i, line := range strings.Split(m.content, "\n") {
+		plain := ansi.Strip(line)
+		text, query := strings.ToLower(plain), strings.ToLower(m.searchQuery)
+		if len(text) != len(plain) {
+			// Lowercasing changed byte offsets, so match the original case
+			text, query = plain, m.searchQuery
+		}
+
+		for offset := 0; ; {
+			index := strings.Index(text[offset:], query)
+			if index < 0 {
+				break
+			}
+			start := offset + index
+			end := start + len(query)
+			m.matches = append(m.matches, searchMatch{
+				line:  i,
+				start: ansi.StringWidth(plain[:start]),
+				end:   ansi.StringWidth(plain[:end]),
+			})
+			offset = end
 		}
-		return AssignmentDetailLoadedMsg{assignment}
 	}
 }
 
-// Messages for the assignment detail model
-type AssignmentDetailLoadedMsg struct {
-	assignment *api.Assignment
+// highlightContent sets the viewport content to the details with each match
+// highlighted, and the current match highlighted differently
+func (m *AssignmentDetailModel) highlightContent() {
+	if len(m.matches) == 0 {
+		m.viewport.SetContent(m.content)
+		return
+	}
+
+	matchStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("0")).Background(lipgloss.Color("226"))
+	currentStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("0")).Background(lipgloss.Color("205")).Bold(true)
+
+	ranges := make(map[int][]lipgloss.Range)
+	for i, match := range m.matches {
+		style := matchStyle
+		if i == m.currentMatch {
+			style = currentStyle
+		}
+		ranges[match.line] = append(ranges[match.line], lipgloss.NewRange(match.start, match.end, style))
+	}
+
+	lines := strings.Split(m.content, "\n")
+	for i, lineRanges := range ranges {
+		lines[i] = lipgloss.StyleRanges(lines[i], lineRanges...)
+	}
+	m.viewport.SetContent(strings.Join(lines, "\n"))
+}
+
+// goToMatch highlights the match at index and scrolls it into the middle of
+// the viewport
+func (m *AssignmentDetailModel) goToMatch(index int) {
+	if len(m.matches) == 0 {
+		m.highlightContent()
+		return
+	}
+	m.currentMatch = (index + len(m.matches)) % len(m.matches)
+	m.highlightContent()
+	m.viewport.SetYOffset(m.matches[m.currentMatch].line - m.viewport.Height/2)
+}
+
+// updateSearch handles key presses while the search input has focus
+func (m AssignmentDetailModel) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		// Clear the search and its highlights
+		m.searching = false
+		m.searchInput.Blur()
+		m.searchInput.SetValue("")
+		m.searchQuery = ""
+		m.findMatches()
+		m.highlightContent()
+		return m, nil
+	case "enter":
+		// Keep the highlights but return to scrolling
+		m.searching = false
+		m.searchInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.searchInput, cmd = m.searchInput.Update(msg)
+	if m.searchInput.Value() != m.searchQuery {
+		m.searchQuery = m.searchInput.Value()
+		m.findMatches()
+		m.goToMatch(0)
+	}
+	return m, cmd
 }
 
-type AssignmentDetailErrorMsg struct {
-	err error
+// Init initializes the assignment detail model
+func (m AssignmentDetailModel) Init() tea.Cmd {
+	return nil
 }
 
 // Update updates the assignment detail model
@@ -130,8 +432,33 @@ func (m AssignmentDetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.searching {
+			return m.updateSearch(msg)
+		}
+
 		switch msg.String() {
-		case "esc", "q", "enter":
+		case "/":
+			if m.ready {
+				m.searching = true
+				return m, m.searchInput.Focus()
+			}
+		case "n":
+			m.goToMatch(m.currentMatch + 1)
+			return m, nil
+		case "N":
+			m.goToMatch(m.currentMatch - 1)
+			return m, nil
+		case "esc":
+			// Clear an active search before quitting
+			if m.searchQuery != "" {
+				m.searchInput.SetValue("")
+				m.searchQuery = ""
+				m.findMatches()
+				m.highlightContent()
+				return m, nil
+			}
+			return m, tea.Quit
+		case "q", "enter":
 			return m, tea.Quit
 		}
 
@@ -152,18 +479,14 @@ func (m AssignmentDetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.viewport.Height = msg.Height - 4
 		}
 
-		if m.assignment != nil {
-			m.viewport.SetContent(m.formatAssignmentDetails())
-		}
-
-	case AssignmentDetailLoadedMsg:
-		m.assignment = msg.assignment
-		if m.ready {
-			m.viewport.SetContent(m.formatAssignmentDetails())
+		// Wrapping depends on the width, so find the matches again
+		m.content = m.formatAssignmentDetails()
+		current := m.currentMatch
+		m.findMatches()
+		if current < len(m.matches) {
+			m.currentMatch = current
 		}
-
-	case AssignmentDetailErrorMsg:
-		return m, tea.Quit
+		m.highlightContent()
 	}
 
 	// Handle viewport updates
@@ -178,11 +501,7 @@ func (m AssignmentDetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // View renders the assignment detail model
 func (m AssignmentDetailModel) View() string {
 	if !m.ready {
-		return "Loading..."
-	}
-
-	if m.assignment == nil {
-		return "Error loading assignment details"
+		return ""
 	}
 
 	// Create header style
@@ -198,10 +517,21 @@ func (m AssignmentDetailModel) View() string {
 		PaddingTop(1).
 		PaddingLeft(2)
 
+	footer := footerStyle.Render("/: search • q/esc/enter: return to list")
+	if m.searching {
+		footer = lipgloss.NewStyle().PaddingTop(1).PaddingLeft(2).Render(m.searchInput.View())
+	} else if m.searchQuery != "" {
+		status := "No matches"
+		if len(m.matches) > 0 {
+			status = fmt.Sprintf("Match %d of %d", m.currentMatch+1, len(m.matches))
+		}
+		footer = footerStyle.Render(fmt.Sprintf("%q: %s • n/N: next/previous • esc: clear search", m.searchQuery, status))
+	}
+
 	// Combine all the parts with header and footer
 	return headerStyle.Render("Assignment Details") + "\n" +
 		m.viewport.View() + "\n" +
-		footerStyle.Render("q/esc/enter: return to list")
+		footer
 }
 
 // formatAssignmentDetails formats the assignment details as a styled string
@@ -247,19 +577,19 @@ func (m AssignmentDetailModel) formatAssignmentDetails() string {
 
 	dueDate := "Not set"
 	if !assignment.DueAt.IsZero() {
-		dueDate = assignment.DueAt.Format("Jan 2, 2006 3:04 PM")
+		dueDate = assignment.DueAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
 	}
 	content.WriteString(labelStyle.Render("Due Date:") + valueStyle.Render(dueDate) + "\n")
 
 	unlockDate := "Not set"
 	if !assignment.UnlockAt.IsZero() {
-		unlockDate = assignment.UnlockAt.Format("Jan 2, 2006 3:04 PM")
+		unlockDate = assignment.UnlockAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
 	}
 	content.WriteString(labelStyle.Render("Available From:") + valueStyle.Render(unlockDate) + "\n")
 
 	lockDate := "Not set"
 	if !assignment.LockAt.IsZero() {
-		lockDate = assignment.LockAt.Format("Jan 2, 2006 3:04 PM")
+		lockDate = assignment.LockAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
 	}
 	content.WriteString(labelStyle.Render("Available Until:") + valueStyle.Render(lockDate) + "\n")
 
@@ -267,6 +597,13 @@ func (m AssignmentDetailModel) formatAssignmentDetails() string {
 	content.WriteString(sectionStyle.Render("Configuration") + "\n")
 
 	content.WriteString(labelStyle.Render("Grading Type:") + valueStyle.Render(assignment.GradingType) + "\n")
+	if assignment.GradingType == "letter_grade" || assignment.GradingType == "gpa_scale" {
+		standard := "Course default"
+		if assignment.GradingStandardID != 0 {
+			standard = strconv.Itoa(assignment.GradingStandardID)
+		}
+		content.WriteString(labelStyle.Render("Grading Scale:") + valueStyle.Render(standard) + "\n")
+	}
 	content.WriteString(labelStyle.Render("Submission Types:") + valueStyle.Render(strings.Join(assignment.SubmissionTypes, ", ")) + "\n")
 
 	publishedStatus := "No"
@@ -278,20 +615,19 @@ func (m AssignmentDetailModel) formatAssignmentDetails() string {
 	// Metadata section
 	content.WriteString(sectionStyle.Render("Metadata") + "\n")
 
-	content.WriteString(labelStyle.Render("Created:") + valueStyle.Render(assignment.CreatedAt.Format("Jan 2, 2006")) + "\n")
+	content.WriteString(labelStyle.Render("Created:") + valueStyle.Render(assignment.CreatedAt.In(config.Location()).Format("Jan 2, 2006")) + "\n")
 
 	updatedAt := "Same as creation date"
 	if !assignment.UpdatedAt.IsZero() && !assignment.CreatedAt.Equal(assignment.UpdatedAt) {
-		updatedAt = assignment.UpdatedAt.Format("Jan 2, 2006")
+		updatedAt = assignment.UpdatedAt.In(config.Location()).Format("Jan 2, 2006")
 	}
 	content.WriteString(labelStyle.Render("Last Updated:") + valueStyle.Render(updatedAt) + "\n")
 
 	// Description section
 	content.WriteString(sectionStyle.Render("Description") + "\n")
 
-	// Wrap description text to fit viewport
-	descriptionStyle := lipgloss.NewStyle().Width(m.width - 6)
-	content.WriteString(descriptionStyle.Render(assignment.Description) + "\n")
+	// Render the HTML description wrapped to fit the viewport
+	content.WriteString(ui.RenderHTML(assignment.Description, m.width-6) + "\n")
 
 	return content.String()
 }
@@ -301,26 +637,47 @@ func runAssignmentsView(cmd *cobra.Command, args []string) {
 	courseID := args[0]
 	assignmentID := args[1]
 
-	// Initialize the assignment detail model
-	model := NewAssignmentDetailModel(courseID, assignmentID)
+	// Show a spinner while the assignment loads, then its details
+	client := newClient()
+	model := ui.NewSpinnerModel("Loading assignment...", func() (interface{}, error) {
+		return client.GetAssignment(courseID, assignmentID)
+	}, func(data interface{}) tea.Model {
+		return NewAssignmentDetailModel(data.(*api.Assignment))
+	})
 
 	// Run the program
-	p := tea.NewProgram(
+	result, err := runProgram(
 		model,
 		tea.WithAltScreen(),       // Use alternate screen buffer
 		tea.WithMouseCellMotion(), // Enable mouse support
 	)
-
-	if _, err := p.Run(); err != nil {
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error running assignment detail view: %v\n", err)
 		return
 	}
+
+	if spinnerModel, ok := result.(ui.SpinnerModel); ok && spinnerModel.Err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching assignment: %v\n", spinnerModel.Err)
+	}
 }
 
-// runAssignmentsAdd runs the add assignment command
-func runAssignmentsAdd(cmd *cobra.Command, args []string) {
-	courseID := args[0]
+// assignmentDateLayout is the format of dates on the assignment form
+const assignmentDateLayout = ui.DatePickerLayout
+
+// validateAssignmentDate checks an optional date entered on the assignment form
+func validateAssignmentDate(s string) error {
+	if s == "" {
+		return nil // optional
+	}
+	if _, err := time.ParseInLocation(assignmentDateLayout, s, config.Location()); err != nil {
+		return fmt.Errorf("invalid date format")
+	}
+	return nil
+}
 
+// runAssignmentForm shows the assignment form pre-populated with form and
+// stores the entered values back into it
+func runAssignmentForm(title, description string, form *AssignmentForm) error {
 	// Available submission types
 	submissionTypes := []string{
 		"online_text_entry",
@@ -339,19 +696,28 @@ func runAssignmentsAdd(cmd *cobra.Command, args []string) {
 		"gpa_scale",
 	}
 
-	// Create the form data structure
-	form := AssignmentForm{
-		GradingType:     "points",
-		SubmissionTypes: []string{"online_text_entry"},
-		Published:       true,
+	// Keep values the form doesn't offer, such as the online_quiz submission
+	// type of a quiz, since huh drops selected values that aren't options
+	if !slices.Contains(gradingTypes, form.GradingType) && form.GradingType != "" {
+		gradingTypes = append(gradingTypes, form.GradingType)
+	}
+	for _, submissionType := range form.SubmissionTypes {
+		if !slices.Contains(submissionTypes, submissionType) {
+			submissionTypes = append(submissionTypes, submissionType)
+		}
+	}
+
+	points := ""
+	if form.PointsPossible != 0 {
+		points = strconv.FormatFloat(form.PointsPossible, 'f', -1, 64)
 	}
 
 	// Build the form with huh
 	formUI := huh.NewForm(
 		huh.NewGroup(
 			huh.NewNote().
-				Title("Create New Assignment").
-				Description("Enter the details for the new assignment"),
+				Title(title).
+				Description(description),
 
 			huh.NewInput().
 				Title("Name").
@@ -369,7 +735,7 @@ func runAssignmentsAdd(cmd *cobra.Command, args []string) {
 				Title("Description").
 				Placeholder("Enter assignment description").
 				Editor("vi").
-				CharLimit(1000).
+				CharLimit(0).
 				Value(&form.Description),
 
 			huh.NewInput().
@@ -387,57 +753,9 @@ func runAssignmentsAdd(cmd *cobra.Command, args []string) {
 					if val < 0 {
 						return fmt.Errorf("points cannot be negative")
 					}
-					form.PointsPossible = val
-					return nil
-				}),
-
-			huh.NewInput().
-				Title("Due Date").
-				Prompt("> ").
-				Placeholder("Format: YYYY-MM-DD HH:MM").
-				Validate(func(s string) error {
-					if s == "" {
-						return nil // optional
-					}
-					_, err := time.Parse("2006-01-02 15:04", s)
-					if err != nil {
-						return fmt.Errorf("invalid date format")
-					}
-					form.DueDate = s
-					return nil
-				}),
-
-			huh.NewInput().
-				Title("Unlock Date").
-				Prompt("> ").
-				Placeholder("Format: YYYY-MM-DD HH:MM (optional)").
-				Validate(func(s string) error {
-					if s == "" {
-						return nil // optional
-					}
-					_, err := time.Parse("2006-01-02 15:04", s)
-					if err != nil {
-						return fmt.Errorf("invalid date format")
-					}
-					form.UnlockDate = s
 					return nil
-				}),
-
-			huh.NewInput().
-				Title("Lock Date").
-				Prompt("> ").
-				Placeholder("Format: YYYY-MM-DD HH:MM (optional)").
-				Validate(func(s string) error {
-					if s == "" {
-						return nil // optional
-					}
-					_, err := time.Parse("2006-01-02 15:04", s)
-					if err != nil {
-						return fmt.Errorf("invalid date format")
-					}
-					form.LockDate = s
-					return nil
-				}),
+				}).
+				Value(&points),
 
 			huh.NewSelect[string]().
 				Title("Grading Type").
@@ -458,46 +776,119 @@ func runAssignmentsAdd(cmd *cobra.Command, args []string) {
 				Description("Make the assignment visible to students").
 				Value(&form.Published),
 		),
+
+		// The calendars are tall, so the dates get a page of their own
+		huh.NewGroup(
+			ui.NewDatePicker().
+				Title("Due Date").
+				Description("Press x for no due date").
+				Value(&form.DueDate),
+
+			ui.NewDatePicker().
+				Title("Unlock Date").
+				Description("Optional").
+				Value(&form.UnlockDate),
+
+			ui.NewDatePicker().
+				Title("Lock Date").
+				Description("Optional").
+				Value(&form.LockDate),
+		),
 	).WithTheme(huh.ThemeBase16())
 
 	// Run the form UI
-	err := formUI.Run()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
-		return
+	if err := formUI.Run(); err != nil {
+		return err
 	}
 
-	// Create the assignment object
-	assignment := &api.Assignment{
-		Name:            form.Name,
-		Description:     form.Description,
-		PointsPossible:  form.PointsPossible,
-		GradingType:     form.GradingType,
-		Published:       form.Published,
-		SubmissionTypes: form.SubmissionTypes,
+	// Points were validated by the form, so an empty value is the only failure
+	form.PointsPossible, _ = strconv.ParseFloat(points, 64)
+	return nil
+}
+
+// apply copies the form values onto assignment
+func (form AssignmentForm) apply(assignment *api.Assignment) {
+	assignment.Name = form.Name
+	assignment.Description = form.Description
+	assignment.PointsPossible = form.PointsPossible
+	assignment.GradingType = form.GradingType
+	assignment.Published = form.Published
+	assignment.SubmissionTypes = form.SubmissionTypes
+
+	// Dates were validated by the form; empty dates clear the field
+	assignment.DueAt, _ = time.ParseInLocation(assignmentDateLayout, form.DueDate, config.Location())
+	assignment.UnlockAt, _ = time.ParseInLocation(assignmentDateLayout, form.UnlockDate, config.Location())
+	assignment.LockAt, _ = time.ParseInLocation(assignmentDateLayout, form.LockDate, config.Location())
+}
+
+// assignmentDateParam returns the value to send for a date entered on the
+// assignment form, or nil to clear the date
+func assignmentDateParam(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	// Dates were validated by the form
+	date, _ := time.ParseInLocation(assignmentDateLayout, s, config.Location())
+	return date.Format(time.RFC3339)
+}
+
+// formatAssignmentDate formats a date for the assignment form, or "" when unset
+func formatAssignmentDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
 	}
+	return t.In(config.Location()).Format(assignmentDateLayout)
+}
 
-	// Parse dates if provided
-	if form.DueDate != "" {
-		dueDate, _ := time.Parse("2006-01-02 15:04", form.DueDate)
-		assignment.DueAt = dueDate
+// printAssignmentError prints an error from creating or updating an
+// assignment, showing Canvas's validation messages rather than the raw response
+func printAssignmentError(action string, err error) {
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) && len(apiErr.Errors) > 0 {
+		fmt.Fprintf(os.Stderr, "Error %s assignment:\n", action)
+		for _, message := range apiErr.Errors {
+			if message.Attribute != "" {
+				fmt.Fprintf(os.Stderr, "  • Assignment %s\n", message)
+			} else {
+				fmt.Fprintf(os.Stderr, "  • %s\n", message)
+			}
+		}
+		return
 	}
+	fmt.Fprintf(os.Stderr, "Error %s assignment: %v\n", action, err)
+}
+
+// runAssignmentsAdd runs the add assignment command
+func runAssignmentsAdd(cmd *cobra.Command, args []string) {
+	courseID := args[0]
 
-	if form.UnlockDate != "" {
-		unlockDate, _ := time.Parse("2006-01-02 15:04", form.UnlockDate)
-		assignment.UnlockAt = unlockDate
+	// Create the form data structure
+	form := AssignmentForm{
+		GradingType:     "points",
+		SubmissionTypes: []string{"online_text_entry"},
+		Published:       true,
 	}
 
-	if form.LockDate != "" {
-		lockDate, _ := time.Parse("2006-01-02 15:04", form.LockDate)
-		assignment.LockAt = lockDate
+	if err := runAssignmentForm("Create New Assignment", "Enter the details for the new assignment", &form); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
 	}
 
+	createAssignment(courseID, form)
+}
+
+// createAssignment creates an assignment from a filled-in form and remembers
+// its settings for `assignments templates save`
+func createAssignment(courseID string, form AssignmentForm) {
+	// Create the assignment object
+	assignment := &api.Assignment{}
+	form.apply(assignment)
+
 	// Call the API
-	client := api.NewClient()
+	client := newClient()
 	newAssignment, err := client.CreateAssignment(courseID, assignment)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error creating assignment: %v\n", err)
+		printAssignmentError("creating", err)
 		return
 	}
 
@@ -509,42 +900,230 @@ func runAssignmentsAdd(cmd *cobra.Command, args []string) {
 
 	// Format and display the dates
 	if !newAssignment.DueAt.IsZero() {
-		fmt.Printf("Due Date: %s\n", newAssignment.DueAt.Format("2006-01-02 15:04"))
+		fmt.Printf("Due Date: %s\n", newAssignment.DueAt.In(config.Location()).Format("2006-01-02 15:04"))
+	}
+
+	if err := writeAssignmentTemplate(lastAssignmentPath(), AssignmentTemplate(form)); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not save the assignment settings for templates: %v\n", err)
 	}
 }
 
-func runAssignmentsList(cmd *cobra.Command, args []string) {
+// runAssignmentsEdit runs the edit assignment command
+func runAssignmentsEdit(cmd *cobra.Command, args []string) {
 	courseID := args[0]
-	client := api.NewClient()
-	assignments, err := client.GetAssignments(courseID)
+	assignmentID := args[1]
+
+	client := newClient()
+	assignment, err := client.GetAssignment(courseID, assignmentID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching assignment: %v\n", err)
+		return
+	}
+
+	// Pre-populate the form with the current values
+	original := AssignmentForm{
+		Name:            assignment.Name,
+		Description:     assignment.Description,
+		PointsPossible:  assignment.PointsPossible,
+		DueDate:         formatAssignmentDate(assignment.DueAt),
+		UnlockDate:      formatAssignmentDate(assignment.UnlockAt),
+		LockDate:        formatAssignmentDate(assignment.LockAt),
+		GradingType:     assignment.GradingType,
+		SubmissionTypes: assignment.SubmissionTypes,
+		Published:       assignment.Published,
+	}
+	form := original
+	form.SubmissionTypes = slices.Clone(original.SubmissionTypes)
+
+	title := fmt.Sprintf("Edit Assignment %d", assignment.ID)
+	if err := runAssignmentForm(title, "Update the details of the assignment", &form); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	// Only send the values that changed, so fields the form can't show in
+	// full, such as the HTML description, are left as they are
+	params := map[string]interface{}{}
+	if form.Name != original.Name {
+		params["name"] = form.Name
+	}
+	if form.Description != original.Description {
+		params["description"] = form.Description
+	}
+	if form.PointsPossible != original.PointsPossible {
+		params["points_possible"] = form.PointsPossible
+	}
+	if form.GradingType != original.GradingType {
+		params["grading_type"] = form.GradingType
+	}
+	// The multi-select lists the types in option order, so compare them sorted
+	if !slices.Equal(slices.Sorted(slices.Values(form.SubmissionTypes)), slices.Sorted(slices.Values(original.SubmissionTypes))) {
+		params["submission_types"] = form.SubmissionTypes
+	}
+	if form.Published != original.Published {
+		params["published"] = form.Published
+	}
+	if form.DueDate != original.DueDate {
+		params["due_at"] = assignmentDateParam(form.DueDate)
+	}
+	if form.UnlockDate != original.UnlockDate {
+		params["unlock_at"] = assignmentDateParam(form.UnlockDate)
+	}
+	if form.LockDate != original.LockDate {
+		params["lock_at"] = assignmentDateParam(form.LockDate)
+	}
+
+	if len(params) == 0 {
+		fmt.Println("No changes to save.")
+		return
+	}
+
+	updated, err := client.UpdateAssignment(courseID, assignment.ID, params)
+	if err != nil {
+		printAssignmentError("updating", err)
+		return
+	}
+
+	fmt.Println("\n✅ Assignment updated successfully!")
+	fmt.Printf("ID: %d\n", updated.ID)
+	fmt.Printf("Name: %s\n", updated.Name)
+	fmt.Printf("Points: %.1f\n", updated.PointsPossible)
+
+	if !updated.DueAt.IsZero() {
+		fmt.Printf("Due Date: %s\n", updated.DueAt.In(config.Location()).Format("2006-01-02 15:04"))
+	}
+}
+
+// runAssignmentsBulkPublish sets the published state of every assignment in a
+// course, or only the unpublished ones when filterUnpublished is set
+func runAssignmentsBulkPublish(courseID string, published, filterUnpublished bool) {
+	client := newClient()
+	assignments, err := client.GetAssignments(courseID, 0, 0)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error fetching assignments: %v\n", err)
 		return
 	}
 
-	// Create a table for assignments
-	columns := []table.Column{
-		{Title: "ID", Width: 10},
-		{Title: "Name", Width: 40},
-		{Title: "Due Date", Width: 20},
-		{Title: "Points", Width: 10},
+	var targets []api.Assignment
+	for _, assignment := range assignments {
+		if filterUnpublished && assignment.Published {
+			continue
+		}
+		targets = append(targets, assignment)
+	}
+
+	action := "Unpublishing"
+	if published {
+		action = "Publishing"
+	}
+
+	if len(targets) == 0 {
+		fmt.Printf("No assignments to update in course %s.\n", courseID)
+		return
+	}
+
+	labels := make([]string, len(targets))
+	for i, assignment := range targets {
+		labels[i] = assignment.Name
+	}
+
+	title := fmt.Sprintf("%s %d assignments in course %s", action, len(targets), courseID)
+	model := ui.NewProgressModel(title, labels, func(i int) error {
+		return client.SetAssignmentPublished(courseID, targets[i].ID, published)
+	})
+
+	result, err := runProgram(model)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		return
+	}
+
+	finalModel, ok := result.(ui.ProgressModel)
+	if !ok {
+		return
+	}
+
+	if !finalModel.Completed {
+		fmt.Println("\nStopped before every assignment was updated.")
+	}
+	fmt.Printf("\nUpdated %d of %d assignments in course %s\n\n", finalModel.Success, len(targets), courseID)
+	fmt.Printf("✅ Success: %d\n", finalModel.Success)
+	fmt.Printf("❌ Failed: %d\n", len(finalModel.Failed))
+	for _, failure := range finalModel.Failed {
+		fmt.Println("   " + failure)
+	}
+}
+
+// assignmentDueLayout is the format of due dates in the assignments list
+const assignmentDueLayout = "Jan 2, 2006 3:04 PM"
+
+// fetchAssignmentRows fetches the assignments of a course in bucket and builds
+// their table rows, naming each assignment's group from groupNames and
+// recording each assignment's web URL in urls by ID
+func fetchAssignmentRows(client *api.Client, courseID, bucket string, groupNames map[int]string, fetched map[string]api.Assignment, pagination paginationOptions) ([]table.Row, error) {
+	assignments, err := fetchPages(pagination, func(page, perPage int) ([]api.Assignment, error) {
+		return client.GetAssignmentsByBucket(courseID, bucket, page, perPage)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	rows := []table.Row{}
 	for _, assignment := range assignments {
 		dueDate := ""
 		if !assignment.DueAt.IsZero() {
-			dueDate = assignment.DueAt.Format("Jan 2, 2006 3:04 PM")
+			dueDate = assignment.DueAt.In(config.Location()).Format(assignmentDueLayout)
 		}
 
+		fetched[fmt.Sprintf("%d", assignment.ID)] = assignment
 		rows = append(rows, table.Row{
 			fmt.Sprintf("%d", assignment.ID),
 			assignment.Name,
+			groupNames[assignment.AssignmentGroupID],
 			dueDate,
 			fmt.Sprintf("%.1f", assignment.PointsPossible),
 		})
 	}
 
+	return rows, nil
+}
+
+func runAssignmentsList(courseID, bucket string, pagination paginationOptions, watch time.Duration) {
+	client := newClient()
+
+	// Assignments only carry their group ID, so look up the group names
+	groupNames := make(map[int]string)
+	groups, err := client.GetAssignmentGroups(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not fetch assignment groups: %v\n", err)
+	}
+	for _, group := range groups {
+		groupNames[group.ID] = group.Name
+	}
+
+	// Keep the fetched assignments so viewing one needs no further requests.
+	// Pages and refreshes are fetched in the background, so guard the map.
+	fetched := make(map[string]api.Assignment)
+	var fetchedMu sync.Mutex
+	rows, err := fetchAssignmentRows(client, courseID, bucket, groupNames, fetched, pagination)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching assignments: %v\n", err)
+		return
+	}
+
+	// Create a table for assignments
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Name", Width: 40},
+		{Title: "Group", Width: 20},
+		{Title: "Due Date", Width: 20},
+		{Title: "Points", Width: 10},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
 	t := table.New(
 		table.WithColumns(columns),
 		table.WithRows(rows),
@@ -552,42 +1131,557 @@ func runAssignmentsList(cmd *cobra.Command, args []string) {
 		table.WithHeight(15),
 	)
 
-	s := table.DefaultStyles()
-	s.Header = s.Header.
-		BorderStyle(lipgloss.NormalBorder()).
-		BorderForeground(lipgloss.Color("240")).
-		BorderBottom(true).
-		Bold(true)
-	s.Selected = s.Selected.
-		Foreground(lipgloss.Color("229")).
-		Background(lipgloss.Color("57")).
-		Bold(true)
-	t.SetStyles(s)
+	t.SetStyles(ui.DefaultTableStyles())
 
 	m := ui.NewTableModel(t)
 	m.Title = fmt.Sprintf("Assignments for Course %s", courseID)
+	if bucket != "" {
+		m.Title = fmt.Sprintf("Assignments for Course %s (%s)", courseID, bucket)
+	}
 	m.Help = "↑/↓: Navigate • enter: View Assignment • q: Quit"
+	m.EnableFilter(-1)
+
+	// Link assignment names to the assignment on the Canvas website
+	m.LinkCellFunc = func(row table.Row, column int) string {
+		if column != 1 {
+			return ""
+		}
+		fetchedMu.Lock()
+		defer fetchedMu.Unlock()
+		return fetched[row[0]].HTMLURL
+	}
+
+	// Color past-due assignments red and those due within 48 hours yellow
+	m.ColorRowFunc = func(row table.Row) lipgloss.Style {
+		dueAt, err := time.ParseInLocation(assignmentDueLayout, row[3], config.Location())
+		if err != nil {
+			return lipgloss.NewStyle()
+		}
+		return ui.DueDateStyle(dueAt)
+	}
+
+	fetchPage := func(page int) ([]table.Row, error) {
+		pageOpts := pagination
+		pageOpts.page = page
+		pageFetched := make(map[string]api.Assignment)
+		rows, err := fetchAssignmentRows(client, courseID, bucket, groupNames, pageFetched, pageOpts)
+		fetchedMu.Lock()
+		defer fetchedMu.Unlock()
+		for id, assignment := range pageFetched {
+			fetched[id] = assignment
+		}
+		return rows, err
+	}
+	if !pagination.all {
+		m.EnablePaging(pagination.page, fetchPage)
+	}
+	watchTable(m, client, watch, fetchPage)
 
-	// Set up the selection callback to view assignment details
+	// Remember the selected assignment so its details can be shown after the
+	// table closes
+	var selected string
+	m.QuitOnSelect = true
 	m.OnSelect = func(row table.Row) {
-		// Get assignment ID from the selected row
-		assignmentID := row[0]
+		selected = row[0]
+	}
+
+	// Returning from the details reopens the list as it was left, with the
+	// same cursor, page, and filter
+	var model tea.Model = m
+	for {
+		selected = ""
+		result, err := runProgram(model)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+			os.Exit(1)
+		}
+		if selected == "" {
+			return
+		}
+
+		fetchedMu.Lock()
+		assignment := fetched[selected]
+		fetchedMu.Unlock()
+		if _, err := runProgram(NewAssignmentDetailModel(&assignment), tea.WithAltScreen(), tea.WithMouseCellMotion()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error running assignment detail view: %v\n", err)
+			return
+		}
+		model = result
+	}
+}
+
+func runAssignmentsMissing(courseID string) {
+	client := newClient()
+	submissions, err := client.GetMissingSubmissions(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching missing submissions: %v\n", err)
+		return
+	}
 
-		// Create the command args for viewing the assignment
-		viewArgs := []string{courseID, assignmentID}
+	// Oldest due dates first, then by student
+	sort.SliceStable(submissions, func(i, j int) bool {
+		a, b := submissions[i], submissions[j]
+		if a.Assignment != nil && b.Assignment != nil && !a.Assignment.DueAt.Equal(b.Assignment.DueAt) {
+			return a.Assignment.DueAt.Before(b.Assignment.DueAt)
+		}
+		return strings.ToLower(a.User.SortableName) < strings.ToLower(b.User.SortableName)
+	})
 
-		// Clear screen
-		fmt.Print("\033[H\033[2J")
+	rows := []table.Row{}
+	for _, submission := range submissions {
+		assignmentName := strconv.Itoa(submission.AssignmentID)
+		dueDate := ""
+		if submission.Assignment != nil {
+			assignmentName = submission.Assignment.Name
+			if !submission.Assignment.DueAt.IsZero() {
+				dueDate = submission.Assignment.DueAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
+			}
+		}
 
-		// Run the view command immediately
-		runAssignmentsView(nil, viewArgs)
+		rows = append(rows, table.Row{
+			submission.User.Name,
+			assignmentName,
+			dueDate,
+		})
+	}
+
+	// Create a table for missing submissions
+	columns := []table.Column{
+		{Title: "Student", Width: 25},
+		{Title: "Assignment", Width: 35},
+		{Title: "Due Date", Width: 20},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
 
-		// After returning from detail view, restart list view
-		runAssignmentsList(nil, args)
+	if len(rows) == 0 {
+		fmt.Printf("No missing submissions in course %s.\n", courseID)
+		return
 	}
 
-	if _, err := tea.NewProgram(m).Run(); err != nil {
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Missing Submissions for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+	m.EnableFilter(-1)
+
+	if _, err := runProgram(m); err != nil {
 		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
 		os.Exit(1)
 	}
 }
+
+// assignmentSubmissionStats holds the submission counts for a single assignment
+type assignmentSubmissionStats struct {
+	assignment api.Assignment
+	total      int
+	submitted  int
+	graded     int
+	ungraded   int
+	missing    int
+}
+
+// percentGraded returns the share of students whose submission has been graded
+func (s assignmentSubmissionStats) percentGraded() float64 {
+	if s.total == 0 {
+		return 100
+	}
+	return float64(s.graded) / float64(s.total) * 100
+}
+
+func runAssignmentsSubmissionsSummary(courseID, sortBy, filter string) {
+	client := newClient()
+	assignments, err := client.GetAssignments(courseID, 0, 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching assignments: %v\n", err)
+		return
+	}
+
+	// Fetch the submission summaries concurrently
+	stats := make([]assignmentSubmissionStats, len(assignments))
+	errs := make([]error, len(assignments))
+	sem := make(chan struct{}, maxConcurrency())
+	var wg sync.WaitGroup
+	for i, assignment := range assignments {
+		wg.Add(1)
+		go func(i int, assignment api.Assignment) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			summary, err := client.GetSubmissionSummary(courseID, strconv.Itoa(assignment.ID))
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", assignment.Name, err)
+				return
+			}
+
+			stat := assignmentSubmissionStats{
+				assignment: assignment,
+				total:      summary.Graded + summary.Ungraded + summary.NotSubmitted,
+				submitted:  summary.Graded + summary.Ungraded,
+				graded:     summary.Graded,
+				ungraded:   summary.Ungraded,
+			}
+			// Unsubmitted work only counts as missing once the due date has passed
+			if !assignment.DueAt.IsZero() && assignment.DueAt.Before(time.Now()) {
+				stat.missing = summary.NotSubmitted
+			}
+			stats[i] = stat
+		}(i, assignment)
+	}
+	wg.Wait()
+
+	var rowsStats []assignmentSubmissionStats
+	for i, stat := range stats {
+		if errs[i] != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching submission summary for %v\n", errs[i])
+			continue
+		}
+
+		switch filter {
+		case "ungraded":
+			if stat.ungraded == 0 {
+				continue
+			}
+		case "missing":
+			if stat.missing == 0 {
+				continue
+			}
+		case "complete":
+			if stat.graded < stat.total {
+				continue
+			}
+		}
+		rowsStats = append(rowsStats, stat)
+	}
+
+	sort.SliceStable(rowsStats, func(i, j int) bool {
+		a, b := rowsStats[i], rowsStats[j]
+		switch sortBy {
+		case "name":
+			return strings.ToLower(a.assignment.Name) < strings.ToLower(b.assignment.Name)
+		case "due":
+			return a.assignment.DueAt.Before(b.assignment.DueAt)
+		case "missing":
+			return a.missing > b.missing
+		case "ungraded":
+			return a.ungraded > b.ungraded
+		default:
+			return a.percentGraded() < b.percentGraded()
+		}
+	})
+
+	// Create a table for the summary
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Name", Width: 30},
+		{Title: "Students", Width: 9},
+		{Title: "Submitted", Width: 10},
+		{Title: "Graded", Width: 8},
+		{Title: "Ungraded", Width: 9},
+		{Title: "Missing", Width: 8},
+		{Title: "% Graded", Width: 9},
+	}
+
+	rows := []table.Row{}
+	for _, stat := range rowsStats {
+		rows = append(rows, table.Row{
+			fmt.Sprintf("%d", stat.assignment.ID),
+			stat.assignment.Name,
+			strconv.Itoa(stat.total),
+			strconv.Itoa(stat.submitted),
+			strconv.Itoa(stat.graded),
+			strconv.Itoa(stat.ungraded),
+			strconv.Itoa(stat.missing),
+			fmt.Sprintf("%.0f%%", stat.percentGraded()),
+		})
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Submission Summary for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// histogramBlocks draw the end of a histogram bar in eighths of a cell
+var histogramBlocks = []rune("▏▎▍▌▋▊▉█")
+
+// histogramBins is the number of bars in a score histogram
+const histogramBins = 10
+
+// scoreStats summarizes a set of scores
+type scoreStats struct {
+	min    float64
+	max    float64
+	mean   float64
+	median float64
+	stdDev float64 // Population standard deviation
+}
+
+// computeScoreStats summarizes scores, which must not be empty
+func computeScoreStats(scores []float64) scoreStats {
+	sorted := slices.Clone(scores)
+	slices.Sort(sorted)
+
+	var sum float64
+	for _, score := range sorted {
+		sum += score
+	}
+	n := len(sorted)
+	stats := scoreStats{
+		min:  sorted[0],
+		max:  sorted[n-1],
+		mean: sum / float64(n),
+	}
+
+	if n%2 == 1 {
+		stats.median = sorted[n/2]
+	} else {
+		stats.median = (sorted[n/2-1] + sorted[n/2]) / 2
+	}
+
+	var squares float64
+	for _, score := range sorted {
+		squares += (score - stats.mean) * (score - stats.mean)
+	}
+	stats.stdDev = math.Sqrt(squares / float64(n))
+
+	return stats
+}
+
+// formatStat formats a score rounded to two decimal places
+func formatStat(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+// histogramBar draws a bar of up to width cells for count out of maxCount,
+// using partial blocks for eighths of a cell
+func histogramBar(count, maxCount, width int) string {
+	if maxCount == 0 {
+		return ""
+	}
+	eighths := count * width * 8 / maxCount
+	bar := strings.Repeat("█", eighths/8)
+	if rest := eighths % 8; rest > 0 {
+		bar += string(histogramBlocks[rest-1])
+	}
+	return bar
+}
+
+// formatScoreHistogram draws the number of scores in each of histogramBins
+// equal ranges from 0 to the points possible, widened to fit every score
+func formatScoreHistogram(scores []float64, pointsPossible float64, width int) string {
+	lower, upper := 0.0, pointsPossible
+	for _, score := range scores {
+		lower = min(lower, score)
+		upper = max(upper, score)
+	}
+	if upper <= lower {
+		upper = lower + 1
+	}
+	binWidth := (upper - lower) / histogramBins
+
+	counts := make([]int, histogramBins)
+	for _, score := range scores {
+		bin := min(int((score-lower)/binWidth), histogramBins-1)
+		counts[bin]++
+	}
+
+	labels := make([]string, histogramBins)
+	labelWidth, maxCount := 0, 0
+	for i := range counts {
+		labels[i] = formatStat(lower+float64(i)*binWidth) + "–" + formatStat(lower+float64(i+1)*binWidth)
+		labelWidth = max(labelWidth, lipgloss.Width(labels[i]))
+		maxCount = max(maxCount, counts[i])
+	}
+	countWidth := len(strconv.Itoa(maxCount))
+	barWidth := max(10, width-labelWidth-countWidth-8)
+
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Width(labelWidth).Align(lipgloss.Right)
+	barStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("39"))
+
+	var b strings.Builder
+	for i, count := range counts {
+		fmt.Fprintf(&b, "%s │ %*d %s\n", labelStyle.Render(labels[i]), countWidth, count, barStyle.Render(histogramBar(count, maxCount, barWidth)))
+	}
+	return b.String()
+}
+
+// formatAssignmentStats formats the score summary box and histogram of an
+// assignment's submissions
+func formatAssignmentStats(assignment *api.Assignment, submissions []api.Submission, width int) string {
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("205"))
+	labelStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39")).Width(12)
+	boxStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		Padding(0, 1)
+
+	var scores []float64
+	var late, missing, excused int
+	for _, submission := range submissions {
+		if submission.Late {
+			late++
+		}
+		if submission.Missing {
+			missing++
+		}
+		if submission.Excused {
+			excused++
+			continue
+		}
+		if submission.WorkflowState == "graded" {
+			scores = append(scores, submission.Score)
+		}
+	}
+
+	lines := []string{
+		labelStyle.Render("Graded") + fmt.Sprintf("%d of %d", len(scores), len(submissions)),
+	}
+	if len(scores) > 0 {
+		stats := computeScoreStats(scores)
+		lines = append(lines,
+			labelStyle.Render("Min")+formatStat(stats.min),
+			labelStyle.Render("Max")+formatStat(stats.max),
+			labelStyle.Render("Mean")+formatStat(stats.mean),
+			labelStyle.Render("Median")+formatStat(stats.median),
+			labelStyle.Render("Std Dev")+formatStat(stats.stdDev),
+		)
+	}
+	lines = append(lines,
+		"",
+		labelStyle.Render("Late")+strconv.Itoa(late),
+		labelStyle.Render("Missing")+strconv.Itoa(missing),
+		labelStyle.Render("Excused")+strconv.Itoa(excused),
+	)
+
+	var content strings.Builder
+	content.WriteString(titleStyle.Render(assignment.Name) + "\n")
+	content.WriteString(fmt.Sprintf("%s points possible\n\n", formatStat(assignment.PointsPossible)))
+	content.WriteString(boxStyle.Render(strings.Join(lines, "\n")) + "\n\n")
+
+	if len(scores) == 0 {
+		content.WriteString("No graded submissions yet.\n")
+		return content.String()
+	}
+	content.WriteString(titleStyle.Render("Score Distribution") + "\n\n")
+	content.WriteString(formatScoreHistogram(scores, assignment.PointsPossible, width-6))
+
+	return content.String()
+}
+
+func runAssignmentsStats(courseID, assignmentID string) {
+	client := newClient()
+	assignment, err := client.GetAssignment(courseID, assignmentID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching assignment: %v\n", err)
+		return
+	}
+
+	submissions, err := client.GetSubmissions(courseID, assignmentID, 0, 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching submissions: %v\n", err)
+		return
+	}
+
+	render := func(width int) string {
+		return formatAssignmentStats(assignment, submissions, width)
+	}
+
+	// Print plain text when the output is piped
+	if !stdoutIsTerminal() {
+		fmt.Println(render(80))
+		return
+	}
+
+	model := ui.NewViewportModel("Assignment Statistics", render)
+	if _, err := runProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running statistics view: %v\n", err)
+	}
+}
+
+func runAssignmentsCalendar(courseID, outputFile string) {
+	client := newClient()
+	course, err := client.GetCourse(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching course: %v\n", err)
+		return
+	}
+
+	assignments, err := client.GetAssignments(courseID, 0, 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching assignments: %v\n", err)
+		return
+	}
+
+	// Event UIDs are scoped to the Canvas host so they stay stable across
+	// exports and calendar apps update events instead of duplicating them
+	host := "canvas"
+	if u, err := url.Parse(client.BaseURL); err == nil && u.Hostname() != "" {
+		host = u.Hostname()
+	}
+
+	cal := &ical.Calendar{
+		ProdID: "-//canvas-cli//Assignments//EN",
+		Name:   course.Name,
+	}
+	for _, assignment := range assignments {
+		if assignment.DueAt.IsZero() {
+			continue
+		}
+		cal.Events = append(cal.Events, ical.Event{
+			UID:         ical.UID(fmt.Sprintf("assignment-%d", assignment.ID), host),
+			Summary:     assignment.Name,
+			Description: ui.HTMLToText(assignment.Description),
+			URL:         assignment.HTMLURL,
+			Start:       assignment.DueAt,
+			End:         assignment.DueAt,
+		})
+	}
+
+	out := os.Stdout
+	if outputFile != "-" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating output file: %v\n", err)
+			return
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if err := ical.NewEncoder(out).Encode(cal); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing calendar: %v\n", err)
+		return
+	}
+
+	if outputFile != "-" {
+		fmt.Printf("Exported %d assignments to %s\n", len(cal.Events), outputFile)
+	}
+}
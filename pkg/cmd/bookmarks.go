@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/spf13/cobra"
+)
+
+// NewBookmarksCmd creates a new command for managing bookmarks
+func NewBookmarksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bookmarks",
+		Short: "Manage saved course and assignment shortcuts",
+		Long: `List and open bookmarks saved with the --bookmark flag.
+
+Bookmarks are created by passing --bookmark [name] to a list or view command,
+for example 'canvas-cli assignments list 123 --bookmark bio101'.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newBookmarksListCmd(),
+		newBookmarksGoCmd(),
+	)
+
+	return cmd
+}
+
+func newBookmarksListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List saved bookmarks",
+		Long:  `List all saved course and assignment bookmarks.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			bookmarks := config.GetConfig().Bookmarks
+			if len(bookmarks) == 0 {
+				fmt.Println("No bookmarks saved. Add one with --bookmark [name] on a list or view command.")
+				return
+			}
+
+			names := make([]string, 0, len(bookmarks))
+			for name := range bookmarks {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			fmt.Println("Bookmarks:")
+			fmt.Println("----------")
+			for _, name := range names {
+				bookmark := bookmarks[name]
+				if bookmark.Type == "assignment" {
+					fmt.Printf("%-20s assignment %s (course %s)\n", name, bookmark.ID, bookmark.CourseID)
+				} else {
+					fmt.Printf("%-20s %s %s\n", name, bookmark.Type, bookmark.ID)
+				}
+			}
+		},
+	}
+}
+
+func newBookmarksGoCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "go [name] [assignments|users]",
+		Short: "Open a bookmark",
+		Long: `Open a saved bookmark.
+
+Course bookmarks open the assignments list for the course, or the users list
+when 'users' is given. Assignment bookmarks open the assignment details.`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := args[0]
+			// Viper keys are case-insensitive and stored lower case
+			bookmark, ok := config.GetConfig().Bookmarks[strings.ToLower(name)]
+			if !ok {
+				fmt.Fprintf(os.Stderr, "No bookmark named %q\n", name)
+				return
+			}
+
+			target := "assignments"
+			if len(args) > 1 {
+				target = args[1]
+			}
+
+			switch {
+			case bookmark.Type == "assignment":
+				runAssignmentsView(nil, []string{bookmark.CourseID, bookmark.ID})
+			case target == "users":
+				runUsersList(bookmark.ID, usersListOptions{pagination: defaultPagination()})
+			case target == "assignments":
+				runAssignmentsList(bookmark.ID, "", defaultPagination(), 0)
+			default:
+				fmt.Fprintf(os.Stderr, "Unknown target %q (expected assignments or users)\n", target)
+			}
+		},
+	}
+}
+
+// addBookmarkFlag adds a --bookmark flag to cmd that saves the command's
+// arguments as a named shortcut of the given type ("course" or "assignment").
+// Course bookmarks use the first argument, assignment bookmarks the first two.
+func addBookmarkFlag(cmd *cobra.Command, bookmarkType string) {
+	var name string
+	cmd.Flags().StringVar(&name, "bookmark", "", "Save the arguments as a named bookmark")
+
+	cmd.PreRun = func(cmd *cobra.Command, args []string) {
+		if name == "" {
+			return
+		}
+
+		bookmark := config.Bookmark{Type: bookmarkType, ID: args[0]}
+		if bookmarkType == "assignment" {
+			bookmark.CourseID = args[0]
+			bookmark.ID = args[1]
+		}
+
+		if err := config.SaveBookmark(strings.ToLower(name), bookmark); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving bookmark: %v\n", err)
+			return
+		}
+		fmt.Printf("Saved bookmark %q\n", name)
+	}
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+)
+
+// configKeys are the keys offered when completing config set
+var configKeys = []string{
+	"api_key\tCanvas API token",
+	"base_url\tCanvas API URL, such as https://school.instructure.com/api/v1",
+	"cache_ttl\tSeconds API responses are reused within a command, 0 to disable",
+	"max_concurrency\tMaximum concurrent API requests for bulk operations",
+	"max_pages\tMaximum pages fetched for one list",
+	"proxy_url\tProxy for API requests, such as http://proxy.example.com:8080",
+	"semester_start_month\tMonth the current semester starts",
+	"timezone\tIANA timezone for dates, such as America/Denver",
+}
+
+// completeCourseIDs completes the first argument with the IDs of the user's
+// courses, described by their names
+func completeCourseIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	courses, err := newClient().GetCourses()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	completions := make([]string, 0, len(courses))
+	for _, course := range courses {
+		completions = append(completions, fmt.Sprintf("%d\t%s", course.ID, course.Name))
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeCourseAndAssignmentIDs completes a course ID followed by the ID of
+// an assignment in that course
+func completeCourseAndAssignmentIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 1 {
+		return completeCourseIDs(cmd, args, toComplete)
+	}
+
+	assignments, err := newClient().GetAssignments(args[0], 0, 0)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	completions := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		completions = append(completions, strconv.Itoa(assignment.ID)+"\t"+assignment.Name)
+	}
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeConfigKeys completes the key of config set
+func completeConfigKeys(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return configKeys, cobra.ShellCompDirectiveNoFileComp
+}
@@ -1,11 +1,18 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
 
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
 	"github.com/Reisender/canvas-cli-v2/pkg/config"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
 )
@@ -17,8 +24,12 @@ type ConfigModel struct {
 	done       bool
 	err        error
 	title      string
+	width      int // Terminal width, 0 until the window size is known
 }
 
+// configMinInputWidth keeps the inputs usable in a very narrow terminal
+const configMinInputWidth = 20
+
 // NewConfigCmd creates a new command for managing configuration
 func NewConfigCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -32,6 +43,8 @@ func NewConfigCmd() *cobra.Command {
 	cmd.AddCommand(
 		newConfigGetCmd(),
 		newConfigSetCmd(),
+		newConfigValidateCmd(),
+		newConfigProfilesCmd(),
 	)
 
 	return cmd
@@ -46,6 +59,9 @@ func newConfigGetCmd() *cobra.Command {
 			cfg := config.GetConfig()
 			fmt.Println("Current Configuration:")
 			fmt.Println("---------------------")
+			if profile := config.ActiveProfile(); profile != "" {
+				fmt.Printf("Profile: %s\n", profile)
+			}
 			fmt.Printf("Base URL: %s\n", cfg.BaseURL)
 
 			// Mask API key for security
@@ -54,12 +70,30 @@ func newConfigGetCmd() *cobra.Command {
 				apiKey = "[set]"
 			}
 			fmt.Printf("API Key: %s\n", apiKey)
+			fmt.Printf("Max Concurrency: %d\n", cfg.MaxConcurrency)
+			fmt.Printf("Cache TTL: %ds\n", cfg.CacheTTL)
+
+			timezone := cfg.Timezone
+			if timezone == "" {
+				timezone = "[system: " + config.Location().String() + "]"
+			}
+			fmt.Printf("Timezone: %s\n", timezone)
+
+			// Mask any proxy password
+			proxyURL := "[from environment]"
+			if cfg.ProxyURL != "" {
+				proxyURL = cfg.ProxyURL
+				if proxy, err := config.ParseProxyURL(cfg.ProxyURL); err == nil {
+					proxyURL = proxy.Redacted()
+				}
+			}
+			fmt.Printf("Proxy URL: %s\n", proxyURL)
 		},
 	}
 }
 
 func newConfigSetCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "set [key] [value]",
 		Short: "Set Canvas CLI configuration",
 		Long:  `Set a configuration value for Canvas CLI.`,
@@ -76,6 +110,240 @@ func newConfigSetCmd() *cobra.Command {
 			fmt.Printf("Successfully updated %s\n", key)
 		},
 	}
+	cmd.ValidArgsFunction = completeConfigKeys
+	return cmd
+}
+
+func newConfigValidateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Check that the configured credentials work",
+		Long:  `Check that the configured base URL is reachable and the API key is accepted by Canvas, and show who you are authenticated as.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runConfigValidate()
+		},
+	}
+}
+
+func runConfigValidate() {
+	cfg := config.GetConfig()
+	fmt.Printf("Base URL: %s\n", cfg.BaseURL)
+	if profile := config.ActiveProfile(); profile != "" {
+		fmt.Printf("Profile:  %s\n", profile)
+	}
+	fmt.Println()
+
+	if cfg.APIKey == "" {
+		fmt.Println("❌ API key not set")
+		fmt.Println("   Run 'canvas-cli config' or 'canvas-cli config set api_key [key]'.")
+		return
+	}
+
+	client := newClient()
+	user, err := client.GetSelf()
+	if err != nil {
+		printCredentialsError(err)
+		return
+	}
+	fmt.Println("✅ Base URL reachable")
+	fmt.Println("✅ API key valid")
+	fmt.Printf("✅ Authenticated as %s\n", user.Name)
+
+	courses, err := client.GetCourses()
+	if err != nil {
+		fmt.Printf("❌ Could not list courses: %v\n", err)
+		return
+	}
+	fmt.Printf("✅ %d courses accessible\n", len(courses))
+}
+
+// printCredentialsError explains why the configured credentials were rejected
+func printCredentialsError(err error) {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		fmt.Println("❌ Base URL unreachable")
+		fmt.Printf("   %v\n", err)
+		fmt.Println("   Check the URL and your network connection.")
+		return
+	}
+
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) {
+		fmt.Println("✅ Base URL reachable")
+		fmt.Println("❌ Base URL did not respond like the Canvas API")
+		fmt.Printf("   %v\n", err)
+		fmt.Println("   Check that the base URL ends in /api/v1.")
+		return
+	}
+
+	fmt.Println("✅ Base URL reachable")
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		fmt.Println("❌ API key rejected (401)")
+		fmt.Println("   The key may be mistyped, expired, or revoked. Generate a new one under")
+		fmt.Println("   Account > Settings > Approved Integrations in Canvas.")
+	case http.StatusForbidden:
+		fmt.Println("❌ API key not permitted (403)")
+		fmt.Println("   The key is valid but may be scoped to exclude reading your user profile.")
+	case http.StatusNotFound:
+		fmt.Println("❌ Canvas API not found (404)")
+		fmt.Println("   Check that the base URL ends in /api/v1.")
+	default:
+		fmt.Printf("❌ Canvas returned an error: %v\n", apiErr)
+	}
+}
+
+func newConfigProfilesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profiles",
+		Short: "Manage profiles for multiple Canvas instances",
+		Long: `Manage named profiles, each with its own Canvas base URL and API key, for
+working with several Canvas instances or institutions.
+
+The current profile's credentials are used instead of the top-level api_key
+and base_url. Use --profile to pick a profile for a single command.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(
+		newConfigProfilesListCmd(),
+		newConfigProfilesAddCmd(),
+		newConfigProfilesUseCmd(),
+		newConfigProfilesDeleteCmd(),
+	)
+
+	return cmd
+}
+
+func newConfigProfilesListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List profiles",
+		Long:  `List the configured profiles, marking the one in use with *.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := config.GetConfig()
+			if len(cfg.Profiles) == 0 {
+				fmt.Println("No profiles configured. Add one with 'canvas-cli config profiles add [name]'.")
+				return
+			}
+
+			names := make([]string, 0, len(cfg.Profiles))
+			for name := range cfg.Profiles {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+
+			active := config.ActiveProfile()
+			for _, name := range names {
+				marker := " "
+				if name == active {
+					marker = "*"
+				}
+				fmt.Printf("%s %-15s %s\n", marker, name, cfg.Profiles[name].BaseURL)
+			}
+		},
+	}
+}
+
+func newConfigProfilesAddCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add [name]",
+		Short: "Add or update a profile",
+		Long:  `Add a profile with its Canvas base URL and API key, or update an existing profile.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runConfigProfilesAdd(strings.ToLower(args[0]))
+		},
+	}
+}
+
+func newConfigProfilesUseCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use [name]",
+		Short: "Switch to a profile",
+		Long:  `Use a profile's credentials for every command until another profile is chosen.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := strings.ToLower(args[0])
+			if err := config.SetCurrentProfile(name); err != nil {
+				fmt.Printf("Error switching profile: %v\n", err)
+				return
+			}
+			fmt.Printf("Now using profile %s\n", name)
+		},
+	}
+}
+
+func newConfigProfilesDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete [name]",
+		Short: "Delete a profile",
+		Long:  `Delete a profile. If it is the current profile, the top-level credentials are used again.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := strings.ToLower(args[0])
+			if err := config.DeleteProfile(name); err != nil {
+				fmt.Printf("Error deleting profile: %v\n", err)
+				return
+			}
+			fmt.Printf("Deleted profile %s\n", name)
+		},
+	}
+}
+
+func runConfigProfilesAdd(name string) {
+	profile, exists := config.GetConfig().Profiles[name]
+	if profile.BaseURL == "" {
+		profile.BaseURL = "https://canvas.instructure.com/api/v1"
+	}
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Base URL").
+				Prompt("> ").
+				Placeholder("https://your-institution.instructure.com/api/v1").
+				Validate(func(s string) error {
+					if strings.TrimSpace(s) == "" {
+						return fmt.Errorf("base URL is required")
+					}
+					return nil
+				}).
+				Value(&profile.BaseURL),
+
+			huh.NewInput().
+				Title("API Key").
+				Prompt("> ").
+				EchoMode(huh.EchoModePassword).
+				Validate(func(s string) error {
+					if strings.TrimSpace(s) == "" {
+						return fmt.Errorf("API key is required")
+					}
+					return nil
+				}).
+				Value(&profile.APIKey),
+		),
+	).WithTheme(huh.ThemeBase16())
+
+	if err := form.Run(); err != nil {
+		fmt.Printf("Error with form: %v\n", err)
+		return
+	}
+
+	if err := config.SaveProfile(name, profile); err != nil {
+		fmt.Printf("Error saving profile: %v\n", err)
+		return
+	}
+
+	if exists {
+		fmt.Printf("Updated profile %s\n", name)
+		return
+	}
+	fmt.Printf("Added profile %s. Switch to it with 'canvas-cli config profiles use %s'.\n", name, name)
 }
 
 func runConfig(cmd *cobra.Command, args []string) {
@@ -111,7 +379,7 @@ func runConfig(cmd *cobra.Command, args []string) {
 		title:      "Canvas CLI Configuration",
 	}
 
-	if _, err := tea.NewProgram(model).Run(); err != nil {
+	if _, err := runProgram(model); err != nil {
 		fmt.Printf("Error running config: %v\n", err)
 	}
 }
@@ -126,6 +394,14 @@ func (m ConfigModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		// Fit the inputs to the terminal, leaving room for the prompt
+		m.width = msg.Width
+		for i := range m.inputs {
+			m.inputs[i].Width = max(configMinInputWidth, msg.Width-10)
+		}
+		return m, nil
+
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+c", "esc":
@@ -215,6 +491,13 @@ func (m ConfigModel) View() string {
 		Bold(true).
 		Foreground(lipgloss.Color("170")).
 		MarginLeft(2)
+	helpStyle := lipgloss.NewStyle()
+
+	// Wrap the title and help to the terminal once its width is known
+	if m.width > 0 {
+		titleStyle = titleStyle.Width(max(configMinInputWidth, m.width-2))
+		helpStyle = helpStyle.Width(max(configMinInputWidth, m.width))
+	}
 
 	s := titleStyle.Render(m.title) + "\n\n"
 
@@ -224,7 +507,7 @@ func (m ConfigModel) View() string {
 	s += "API Key:" + "\n"
 	s += m.inputs[1].View() + "\n\n"
 
-	s += "Press Enter to save, Esc to cancel\n"
+	s += helpStyle.Render("Press Enter to save, Esc to cancel") + "\n"
 
 	return s
 }
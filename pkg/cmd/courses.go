@@ -3,11 +3,18 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
 	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/spf13/cobra"
 )
@@ -18,27 +25,209 @@ func NewCoursesCmd() *cobra.Command {
 		Use:   "courses",
 		Short: "Manage Canvas courses",
 		Long:  `List, view, and interact with your Canvas courses.`,
-		Run:   runCoursesList,
+		Run: func(cmd *cobra.Command, args []string) {
+			runCoursesList(coursesListOptions{sortBy: "favorites"})
+		},
 	}
 
 	// Add subcommands
 	cmd.AddCommand(
 		newCoursesListCmd(),
 		newCoursesViewCmd(),
+		newCoursesCreateCmd(),
+		newCoursesEditCmd(),
+		newCoursesCopyCmd(),
+		newCoursesSyllabusCmd(),
+		newCoursesLatePolicyCmd(),
+		newCoursesGradingStandardsCmd(),
 	)
 
 	return cmd
 }
 
+// coursesListOptions controls which courses are fetched and shown by runCoursesList
+type coursesListOptions struct {
+	role       string
+	startAfter time.Time
+	endBefore  time.Time
+	sortBy     string
+	pagination paginationOptions
+}
+
 func newCoursesListCmd() *cobra.Command {
-	return &cobra.Command{
+	var role string
+	var student, teacher, ta bool
+	var startAfter, endBefore string
+	var current bool
+	var sortBy string
+	var pagination paginationOptions
+
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List Canvas courses",
-		Long:  `List all courses you have access to in Canvas.`,
-		Run:   runCoursesList,
+		Long: `List all courses you have access to in Canvas.
+
+Use --role (or one of the --student, --teacher, --ta shortcuts) to only show
+courses where you are enrolled with that role.
+
+Use --start-after and --end-before (YYYY-MM-DD) to only show courses within a
+date range, or --current to limit the list to the current semester. Semesters
+are six months long, starting in the month set by the semester_start_month
+config key (default 8, August).
+
+Favorite courses are marked with ★ and listed first. Use --sort to order the
+list by name, code, or id instead.
+
+Results are fetched one page at a time; use --page and --per-page to choose
+the page, or --all to fetch every page.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			// Resolve the shortcut flags into a role
+			shortcuts := map[string]bool{"student": student, "teacher": teacher, "ta": ta}
+			for name, set := range shortcuts {
+				if !set {
+					continue
+				}
+				if role != "" && role != name {
+					fmt.Fprintf(os.Stderr, "Error: conflicting roles %q and %q\n", role, name)
+					return
+				}
+				role = name
+			}
+
+			if role != "" && !isValidCourseRole(role) {
+				fmt.Fprintf(os.Stderr, "Error: invalid role %q (expected student, teacher, ta, observer, or designer)\n", role)
+				return
+			}
+
+			if err := pagination.validate(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return
+			}
+
+			if !isValidCourseSort(sortBy) {
+				fmt.Fprintf(os.Stderr, "Error: invalid sort %q (expected favorites, name, code, or id)\n", sortBy)
+				return
+			}
+
+			opts := coursesListOptions{role: role, sortBy: sortBy, pagination: pagination}
+
+			if current {
+				opts.startAfter, opts.endBefore = currentSemester(time.Now(), config.GetConfig().SemesterStartMonth)
+			}
+
+			if startAfter != "" {
+				date, err := time.ParseInLocation("2006-01-02", startAfter, config.Location())
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: invalid --start-after date %q (expected YYYY-MM-DD)\n", startAfter)
+					return
+				}
+				opts.startAfter = date
+			}
+
+			if endBefore != "" {
+				date, err := time.ParseInLocation("2006-01-02", endBefore, config.Location())
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: invalid --end-before date %q (expected YYYY-MM-DD)\n", endBefore)
+					return
+				}
+				opts.endBefore = date
+			}
+
+			runCoursesList(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&role, "role", "", "Only show courses where you have this role (student, teacher, ta, observer, designer)")
+	cmd.Flags().BoolVar(&student, "student", false, "Shortcut for --role student")
+	cmd.Flags().BoolVar(&teacher, "teacher", false, "Shortcut for --role teacher")
+	cmd.Flags().BoolVar(&ta, "ta", false, "Shortcut for --role ta")
+	cmd.Flags().StringVar(&startAfter, "start-after", "", "Only show courses starting on or after this date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&endBefore, "end-before", "", "Only show courses ending on or before this date (YYYY-MM-DD)")
+	cmd.Flags().BoolVar(&current, "current", false, "Only show courses in the current semester")
+	cmd.Flags().StringVar(&sortBy, "sort", "favorites", "Sort order (favorites, name, code, id)")
+	addPaginationFlags(cmd, &pagination)
+
+	return cmd
+}
+
+// isValidCourseRole reports whether role is an enrollment type accepted by /courses
+func isValidCourseRole(role string) bool {
+	switch role {
+	case "student", "teacher", "ta", "observer", "designer":
+		return true
+	}
+	return false
+}
+
+// isValidCourseSort reports whether sortBy is a supported courses list sort order
+func isValidCourseSort(sortBy string) bool {
+	switch sortBy {
+	case "favorites", "name", "code", "id":
+		return true
+	}
+	return false
+}
+
+// sortCourses orders courses by the given sort order. The favorites order
+// lists favorite courses first and otherwise keeps the API order.
+func sortCourses(courses []api.Course, sortBy string) {
+	switch sortBy {
+	case "name":
+		sort.SliceStable(courses, func(i, j int) bool {
+			return strings.ToLower(courses[i].Name) < strings.ToLower(courses[j].Name)
+		})
+	case "code":
+		sort.SliceStable(courses, func(i, j int) bool {
+			return strings.ToLower(courses[i].CourseCode) < strings.ToLower(courses[j].CourseCode)
+		})
+	case "id":
+		sort.SliceStable(courses, func(i, j int) bool {
+			return courses[i].ID < courses[j].ID
+		})
+	default:
+		sort.SliceStable(courses, func(i, j int) bool {
+			return courses[i].IsFavorite && !courses[j].IsFavorite
+		})
 	}
 }
 
+// currentSemester returns the start and end of the six-month semester containing
+// now, where one semester begins in startMonth and the other six months later.
+func currentSemester(now time.Time, startMonth int) (time.Time, time.Time) {
+	if startMonth < 1 || startMonth > 12 {
+		startMonth = 8
+	}
+
+	// Months since the most recent semester boundary
+	offset := (int(now.Month()) - startMonth + 12) % 6
+
+	start := time.Date(now.Year(), now.Month()-time.Month(offset), 1, 0, 0, 0, 0, now.Location())
+	end := start.AddDate(0, 6, 0).Add(-time.Nanosecond)
+
+	return start, end
+}
+
+// filterCoursesByDate drops courses outside the given date range. Courses
+// without a start or end date are dropped when the matching bound is set.
+func filterCoursesByDate(courses []api.Course, startAfter, endBefore time.Time) []api.Course {
+	if startAfter.IsZero() && endBefore.IsZero() {
+		return courses
+	}
+
+	var filtered []api.Course
+	for _, course := range courses {
+		if !startAfter.IsZero() && (course.StartAt.IsZero() || course.StartAt.Before(startAfter)) {
+			continue
+		}
+		if !endBefore.IsZero() && (course.EndAt.IsZero() || course.EndAt.After(endBefore)) {
+			continue
+		}
+		filtered = append(filtered, course)
+	}
+
+	return filtered
+}
+
 func newCoursesViewCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "view [course-id]",
@@ -46,36 +235,626 @@ func newCoursesViewCmd() *cobra.Command {
 		Long:  `View details about a specific Canvas course.`,
 		Args:  cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			fmt.Println("View course:", args[0])
-			// TODO: Implement course view
+			runCoursesView(args[0])
+		},
+	}
+}
+
+func newCoursesCreateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "create [account-id]",
+		Short: "Create a new course",
+		Long: `Create a new course in a Canvas account with interactive form input.
+
+Creating courses requires permission to manage courses in the account.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runCoursesCreate(args[0])
+		},
+	}
+}
+
+func newCoursesEditCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "edit [course-id]",
+		Short: "Edit a course's settings",
+		Long:  `Edit a Canvas course's name, code, dates, and status with an interactive form pre-populated with its current values.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runCoursesEdit(args[0])
 		},
 	}
 }
 
-func runCoursesList(cmd *cobra.Command, args []string) {
-	client := api.NewClient()
-	courses, err := client.GetCourses()
+func newCoursesCopyCmd() *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "copy [source-course-id]",
+		Short: "Copy a course into a new course",
+		Long: `Create a new course in the same account as the source course and copy the
+source course's content into it.
+
+The new course is named after the source course with " (Copy)" appended
+unless --name is given. Copying can take several minutes for large courses;
+if you stop waiting, the copy carries on in Canvas.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runCoursesCopy(args[0], name)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Name of the new course")
+
+	return cmd
+}
+
+// courseDateLayout is the format of dates entered on the course form
+const courseDateLayout = "2006-01-02"
+
+// CourseForm represents the data collected from the course form
+type CourseForm struct {
+	Name                string
+	CourseCode          string
+	StartDate           string
+	EndDate             string
+	SISCourseID         string
+	TermID              string
+	RestrictEnrollments bool
+	WorkflowState       string
+}
+
+// courseStateEvents maps the course states that can be chosen on the edit
+// form to the event Canvas uses to move a course into that state
+var courseStateEvents = map[string]string{
+	"unpublished": "claim",
+	"available":   "offer",
+	"completed":   "conclude",
+}
+
+// validateCourseDate checks an optional date entered on the course form
+func validateCourseDate(s string) error {
+	if s == "" {
+		return nil // optional
+	}
+	if _, err := time.ParseInLocation(courseDateLayout, s, config.Location()); err != nil {
+		return fmt.Errorf("invalid date format (expected YYYY-MM-DD)")
+	}
+	return nil
+}
+
+// validateCourseDates checks that the end date on the course form is after
+// the start date when both are set
+func validateCourseDates(startDate, endDate string) error {
+	if startDate == "" || endDate == "" {
+		return nil
+	}
+	start, err := time.ParseInLocation(courseDateLayout, startDate, config.Location())
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error fetching courses: %v\n", err)
+		return nil // reported by validateCourseDate
+	}
+	end, err := time.ParseInLocation(courseDateLayout, endDate, config.Location())
+	if err != nil {
+		return nil
+	}
+	if !end.After(start) {
+		return fmt.Errorf("end date must be after the start date")
+	}
+	return nil
+}
+
+// runCourseForm shows the course form pre-populated with form and stores the
+// entered values back into it. When editing, the course state can be changed
+// but the SIS course ID and term cannot.
+func runCourseForm(title, description string, form *CourseForm, editing bool) error {
+	fields := []huh.Field{
+		huh.NewNote().
+			Title(title).
+			Description(description),
+
+		huh.NewInput().
+			Title("Name").
+			Prompt("> ").
+			Placeholder("Enter course name").
+			Validate(func(s string) error {
+				if strings.TrimSpace(s) == "" {
+					return fmt.Errorf("name is required")
+				}
+				return nil
+			}).
+			Value(&form.Name),
+
+		huh.NewInput().
+			Title("Course Code").
+			Prompt("> ").
+			Placeholder("Enter course code (e.g. BIO-101)").
+			Value(&form.CourseCode),
+
+		huh.NewInput().
+			Title("Start Date").
+			Prompt("> ").
+			Placeholder("Format: YYYY-MM-DD (optional)").
+			Validate(validateCourseDate).
+			Value(&form.StartDate),
+
+		huh.NewInput().
+			Title("End Date").
+			Prompt("> ").
+			Placeholder("Format: YYYY-MM-DD (optional)").
+			Validate(func(s string) error {
+				if err := validateCourseDate(s); err != nil {
+					return err
+				}
+				return validateCourseDates(form.StartDate, s)
+			}).
+			Value(&form.EndDate),
+	}
+
+	if editing {
+		fields = append(fields,
+			huh.NewSelect[string]().
+				Title("Status").
+				Options(
+					huh.NewOption("Unpublished", "unpublished"),
+					huh.NewOption("Available (published)", "available"),
+					huh.NewOption("Completed (concluded)", "completed"),
+				).
+				Value(&form.WorkflowState),
+		)
+	} else {
+		fields = append(fields,
+			huh.NewInput().
+				Title("SIS Course ID").
+				Prompt("> ").
+				Placeholder("Enter SIS course ID (optional)").
+				Value(&form.SISCourseID),
+
+			huh.NewInput().
+				Title("Enrollment Term ID").
+				Prompt("> ").
+				Placeholder("Enter term ID (optional, defaults to the account's default term)").
+				Validate(func(s string) error {
+					if s == "" {
+						return nil
+					}
+					if _, err := strconv.Atoi(s); err != nil {
+						return fmt.Errorf("term ID must be a number")
+					}
+					return nil
+				}).
+				Value(&form.TermID),
+		)
+	}
+
+	fields = append(fields,
+		huh.NewConfirm().
+			Title("Restrict Enrollments to Course Dates").
+			Description("Students can only participate between the start and end dates").
+			Value(&form.RestrictEnrollments),
+	)
+
+	formUI := huh.NewForm(huh.NewGroup(fields...)).WithTheme(huh.ThemeBase16())
+	if err := formUI.Run(); err != nil {
+		return err
+	}
+
+	// The start date may have been changed after the end date was entered
+	return validateCourseDates(form.StartDate, form.EndDate)
+}
+
+// apply copies the form values onto course
+func (form CourseForm) apply(course *api.Course) {
+	course.Name = form.Name
+	course.CourseCode = form.CourseCode
+	course.SISCourseID = form.SISCourseID
+	course.RestrictEnrollments = form.RestrictEnrollments
+
+	// The term ID and dates were validated by the form; empty values clear them
+	course.EnrollmentTermID, _ = strconv.Atoi(form.TermID)
+	course.StartAt, _ = time.ParseInLocation(courseDateLayout, form.StartDate, config.Location())
+	course.EndAt, _ = time.ParseInLocation(courseDateLayout, form.EndDate, config.Location())
+}
+
+// formatCourseDate formats a date for the course form, or "" when unset
+func formatCourseDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(config.Location()).Format(courseDateLayout)
+}
+
+// courseDateParam returns the value to send for a course date entered on the
+// course form, or nil to clear the date
+func courseDateParam(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	// Dates were validated by the form
+	date, _ := time.ParseInLocation(courseDateLayout, s, config.Location())
+	return date.Format(time.RFC3339)
+}
+
+func runCoursesCreate(accountID string) {
+	var form CourseForm
+	if err := runCourseForm("Create New Course", "Fill out the details for the new course", &form, false); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
 		return
 	}
 
-	// Create a table for courses
-	columns := []table.Column{
-		{Title: "ID", Width: 10},
-		{Title: "Course Code", Width: 15},
-		{Title: "Name", Width: 40},
+	course := &api.Course{}
+	form.apply(course)
+
+	client := newClient()
+	created, err := client.CreateCourse(accountID, course)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating course: %v\n", err)
+		return
+	}
+
+	fmt.Println("\n✅ Course created successfully!")
+	fmt.Printf("ID: %d\n", created.ID)
+
+	runCoursesView(strconv.Itoa(created.ID))
+}
+
+func runCoursesEdit(courseID string) {
+	client := newClient()
+	course, err := client.GetCourse(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching course: %v\n", err)
+		return
+	}
+
+	// Pre-populate the form with the current values
+	original := CourseForm{
+		Name:                course.Name,
+		CourseCode:          course.CourseCode,
+		StartDate:           formatCourseDate(course.StartAt),
+		EndDate:             formatCourseDate(course.EndAt),
+		RestrictEnrollments: course.RestrictEnrollments,
+		WorkflowState:       course.Workflow,
+	}
+	form := original
+
+	title := fmt.Sprintf("Edit Course %d", course.ID)
+	if err := runCourseForm(title, "Update the details of the course", &form, true); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	// Only send the values that changed, so unchanged dates keep their time of day
+	params := map[string]interface{}{}
+	if form.Name != original.Name {
+		params["name"] = form.Name
+	}
+	if form.CourseCode != original.CourseCode {
+		params["course_code"] = form.CourseCode
+	}
+	if form.StartDate != original.StartDate {
+		params["start_at"] = courseDateParam(form.StartDate)
+	}
+	if form.EndDate != original.EndDate {
+		params["end_at"] = courseDateParam(form.EndDate)
+	}
+	if form.RestrictEnrollments != original.RestrictEnrollments {
+		params["restrict_enrollments_to_course_dates"] = form.RestrictEnrollments
+	}
+	// Courses in other states, such as deleted, are left in their state
+	if _, ok := courseStateEvents[original.WorkflowState]; ok && form.WorkflowState != original.WorkflowState {
+		params["event"] = courseStateEvents[form.WorkflowState]
+	}
+
+	if len(params) == 0 {
+		fmt.Println("No changes to save.")
+		return
+	}
+
+	updated, err := client.UpdateCourse(courseID, params)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating course: %v\n", err)
+		return
+	}
+
+	fmt.Println("\n✅ Course updated successfully!")
+	fmt.Printf("ID: %d\n", updated.ID)
+	fmt.Printf("Name: %s\n", updated.Name)
+	fmt.Printf("Status: %s\n", updated.Workflow)
+}
+
+// courseCopyPollInterval is how often the state of a course copy is checked
+const courseCopyPollInterval = 2 * time.Second
+
+// CourseCopyModel shows a spinner while waiting for a course copy to finish
+type CourseCopyModel struct {
+	client    *api.Client
+	migration *api.ContentMigration
+	spinner   spinner.Model
+	err       error
+	done      bool
+}
+
+// courseCopyStatusMsg carries the result of checking a course copy's state
+type courseCopyStatusMsg struct {
+	migration *api.ContentMigration
+	err       error
+}
+
+// NewCourseCopyModel creates a model that polls migration until it finishes
+func NewCourseCopyModel(client *api.Client, migration *api.ContentMigration) CourseCopyModel {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+
+	return CourseCopyModel{
+		client:    client,
+		migration: migration,
+		spinner:   s,
+	}
+}
+
+// poll returns a command that checks the copy's state after a short wait
+func (m CourseCopyModel) poll() tea.Cmd {
+	courseID := strconv.Itoa(m.migration.CourseID)
+	migrationID := strconv.Itoa(m.migration.ID)
+	return tea.Tick(courseCopyPollInterval, func(time.Time) tea.Msg {
+		migration, err := m.client.GetContentMigration(courseID, migrationID)
+		return courseCopyStatusMsg{migration: migration, err: err}
+	})
+}
+
+func (m CourseCopyModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.poll())
+}
+
+func (m CourseCopyModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c", "esc":
+			return m, tea.Quit
+		}
+
+	case courseCopyStatusMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+		m.migration = msg.migration
+
+		switch m.migration.WorkflowState {
+		case "completed", "failed":
+			m.done = true
+			return m, tea.Quit
+		}
+		return m, m.poll()
+
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m CourseCopyModel) View() string {
+	if m.done || m.err != nil {
+		return ""
+	}
+
+	state := strings.ReplaceAll(m.migration.WorkflowState, "_", " ")
+	return fmt.Sprintf("\n%s Copying into course %d (%s)...\n\nq: Stop waiting\n",
+		m.spinner.View(), m.migration.CourseID, state)
+}
+
+func runCoursesCopy(sourceCourseID, name string) {
+	client := newClient()
+
+	source, err := client.GetCourse(sourceCourseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching course: %v\n", err)
+		return
+	}
+	if name == "" {
+		name = source.Name + " (Copy)"
+	}
+
+	migration, err := client.CopyCourse(source, name)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error copying course: %v\n", err)
+		return
 	}
 
+	// Nothing was created, so there is no copy to wait for
+	if api.DryRun {
+		return
+	}
+
+	result, err := runProgram(NewCourseCopyModel(client, migration))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		return
+	}
+
+	finalModel, ok := result.(CourseCopyModel)
+	if !ok {
+		return
+	}
+
+	switch {
+	case finalModel.err != nil:
+		fmt.Fprintf(os.Stderr, "Error checking course copy: %v\n", finalModel.err)
+		fmt.Printf("New course ID: %d\n", migration.CourseID)
+	case !finalModel.done:
+		fmt.Printf("Stopped waiting; the copy into course %d continues in Canvas.\n", migration.CourseID)
+	case finalModel.migration.WorkflowState == "failed":
+		fmt.Fprintf(os.Stderr, "Error: copying into course %d failed\n", migration.CourseID)
+	default:
+		fmt.Println("\n✅ Course copied successfully!")
+		fmt.Printf("ID: %d\n", migration.CourseID)
+		fmt.Printf("Name: %s\n", name)
+	}
+}
+
+func runCoursesView(courseID string) {
+	client := newClient()
+	course, err := client.GetCourse(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching course: %v\n", err)
+		return
+	}
+
+	render := func(width int) string {
+		return formatCourse(course)
+	}
+
+	// Print plain text when the output is piped
+	if !stdoutIsTerminal() {
+		fmt.Println(render(80))
+		return
+	}
+
+	model := ui.NewViewportModel("Course", render)
+	if _, err := runProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running course view: %v\n", err)
+	}
+}
+
+// formatCourse formats the details of a course
+func formatCourse(course *api.Course) string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("205")).
+		Bold(true)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("39")).
+		Bold(true).
+		Width(22)
+
+	formatDate := func(t time.Time) string {
+		if t.IsZero() {
+			return "Not set"
+		}
+		return t.In(config.Location()).Format("Jan 2, 2006")
+	}
+
+	var content strings.Builder
+	content.WriteString(titleStyle.Render(course.Name) + "\n\n")
+	content.WriteString(labelStyle.Render("ID:") + strconv.Itoa(course.ID) + "\n")
+	content.WriteString(labelStyle.Render("Course Code:") + course.CourseCode + "\n")
+	content.WriteString(labelStyle.Render("Status:") + courseStatusStyle(course.Workflow).Render(course.Workflow) + "\n")
+	content.WriteString(labelStyle.Render("Start Date:") + formatDate(course.StartAt) + "\n")
+	content.WriteString(labelStyle.Render("End Date:") + formatDate(course.EndAt) + "\n")
+	if course.SISCourseID != "" {
+		content.WriteString(labelStyle.Render("SIS Course ID:") + course.SISCourseID + "\n")
+	}
+	content.WriteString(labelStyle.Render("Account ID:") + strconv.Itoa(course.AccountID) + "\n")
+	content.WriteString(labelStyle.Render("Enrollment Term ID:") + strconv.Itoa(course.EnrollmentTermID) + "\n")
+	content.WriteString(labelStyle.Render("Restrict to Dates:") + yesNo(course.RestrictEnrollments) + "\n")
+
+	return content.String()
+}
+
+// fetchFavoriteCourseIDs returns the IDs of the current user's favorite courses
+func fetchFavoriteCourseIDs(client *api.Client) (map[int]bool, error) {
+	courses, err := client.GetFavoriteCourses()
+	if err != nil {
+		return nil, err
+	}
+
+	favorites := make(map[int]bool, len(courses))
+	for _, course := range courses {
+		favorites[course.ID] = true
+	}
+	return favorites, nil
+}
+
+// fetchCourseRows fetches courses matching opts and builds their table rows,
+// marking the courses in favorites
+func fetchCourseRows(client *api.Client, opts coursesListOptions, favorites map[int]bool) ([]table.Row, error) {
+	courses, err := fetchPages(opts.pagination, func(page, perPage int) ([]api.Course, error) {
+		return client.GetCoursesByEnrollmentType(opts.role, page, perPage)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	courses = filterCoursesByDate(courses, opts.startAfter, opts.endBefore)
+
+	for i := range courses {
+		courses[i].IsFavorite = favorites[courses[i].ID]
+	}
+	sortCourses(courses, opts.sortBy)
+
 	rows := []table.Row{}
 	for _, course := range courses {
+		star := ""
+		if course.IsFavorite {
+			star = "★"
+		}
+
 		rows = append(rows, table.Row{
+			star,
 			fmt.Sprintf("%d", course.ID),
 			course.CourseCode,
 			course.Name,
+			course.Workflow,
 		})
 	}
 
+	return rows, nil
+}
+
+// courseStatusStyle returns the style used to display a course workflow state
+func courseStatusStyle(state string) lipgloss.Style {
+	style := lipgloss.NewStyle()
+	switch state {
+	case "available":
+		return style.Foreground(lipgloss.Color("42"))
+	case "completed":
+		return style.Foreground(lipgloss.Color("39"))
+	case "deleted":
+		return style.Foreground(lipgloss.Color("196")).Strikethrough(true)
+	case "created", "claimed", "unpublished":
+		return style.Foreground(lipgloss.Color("226"))
+	}
+	return style
+}
+
+func runCoursesList(opts coursesListOptions) {
+	if opts.pagination.perPage == 0 {
+		opts.pagination = defaultPagination()
+	}
+
+	client := newClient()
+
+	// Favorites are only decoration, so carry on without them on error
+	favorites, err := fetchFavoriteCourseIDs(client)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: could not fetch favorite courses: %v\n", err)
+	}
+
+	rows, err := fetchCourseRows(client, opts, favorites)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching courses: %v\n", err)
+		return
+	}
+
+	// Create a table for courses
+	columns := []table.Column{
+		{Title: "★", Width: 2},
+		{Title: "ID", Width: 10},
+		{Title: "Course Code", Width: 15},
+		{Title: "Name", Width: 40},
+		{Title: "Status", Width: 10},
+	}
+
+	// Use a readable key for the favorite column in machine-readable output
+	outputColumns := append([]table.Column{{Title: "Favorite"}}, columns[1:]...)
+	if writeOutput(outputColumns, rows) {
+		return
+	}
+
 	t := table.New(
 		table.WithColumns(columns),
 		table.WithRows(rows),
@@ -83,23 +862,41 @@ func runCoursesList(cmd *cobra.Command, args []string) {
 		table.WithHeight(10),
 	)
 
-	s := table.DefaultStyles()
-	s.Header = s.Header.
-		BorderStyle(lipgloss.NormalBorder()).
-		BorderForeground(lipgloss.Color("240")).
-		BorderBottom(true).
-		Bold(true)
-	s.Selected = s.Selected.
-		Foreground(lipgloss.Color("229")).
-		Background(lipgloss.Color("57")).
-		Bold(true)
-	t.SetStyles(s)
+	t.SetStyles(ui.DefaultTableStyles())
 
 	m := ui.NewTableModel(t)
 	m.Title = "Canvas Courses"
 	m.Help = "↑/↓: Navigate • enter: Select • q: Quit"
+	m.EnableFilter(-1)
+
+	// Color the favorite star and the Status column by workflow state
+	m.ColorCellFunc = func(row table.Row, column int) lipgloss.Style {
+		switch column {
+		case 0:
+			return lipgloss.NewStyle().Foreground(lipgloss.Color("220"))
+		case 4:
+			return courseStatusStyle(row[4])
+		}
+		return lipgloss.NewStyle()
+	}
+
+	// Link course names to the course on the Canvas website
+	m.LinkCellFunc = func(row table.Row, column int) string {
+		if column != 3 {
+			return ""
+		}
+		return client.WebURL("/courses/" + row[1])
+	}
+
+	if !opts.pagination.all {
+		m.EnablePaging(opts.pagination.page, func(page int) ([]table.Row, error) {
+			pageOpts := opts
+			pageOpts.pagination.page = page
+			return fetchCourseRows(client, pageOpts, favorites)
+		})
+	}
 
-	if _, err := tea.NewProgram(m).Run(); err != nil {
+	if _, err := runProgram(m); err != nil {
 		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
 		os.Exit(1)
 	}
@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/spf13/cobra"
+)
+
+// dashboardCardHeight is the number of lines a course card takes, including
+// its border
+const dashboardCardHeight = 5
+
+// NewDashboardCmd creates a new command for an overview of active courses
+func NewDashboardCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "dashboard",
+		Short: "Show an overview of your active courses",
+		Long: `Show a card for each active course with its term, the number of assignments
+due in the next week, and the number of unread announcements.
+
+Select a course and press enter to list its assignments.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runDashboard()
+		},
+	}
+}
+
+// dashboardCourse is the summary of one course on the dashboard
+type dashboardCourse struct {
+	course      api.Course
+	dueCount    int
+	unreadCount int
+	err         error
+}
+
+// DashboardModel shows active courses as a list of cards
+type DashboardModel struct {
+	courses  []dashboardCourse
+	cursor   int
+	offset   int // First visible card
+	width    int
+	height   int
+	selected string // ID of the course chosen with enter
+}
+
+// newDashboardModel creates a dashboard of courses
+func newDashboardModel(courses []dashboardCourse) DashboardModel {
+	return DashboardModel{courses: courses, width: 80, height: 24}
+}
+
+func (m DashboardModel) Init() tea.Cmd {
+	return nil
+}
+
+// visibleCards returns how many cards fit below the title and above the help
+func (m DashboardModel) visibleCards() int {
+	return max(1, (m.height-4)/dashboardCardHeight)
+}
+
+func (m DashboardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c", "esc":
+			return m, tea.Quit
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.courses)-1 {
+				m.cursor++
+			}
+		case "home", "g":
+			m.cursor = 0
+		case "end", "G":
+			m.cursor = len(m.courses) - 1
+		case "enter":
+			if len(m.courses) > 0 {
+				m.selected = strconv.Itoa(m.courses[m.cursor].course.ID)
+				return m, tea.Quit
+			}
+		}
+	}
+
+	// Keep the cursor's card on screen
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if visible := m.visibleCards(); m.cursor >= m.offset+visible {
+		m.offset = m.cursor - visible + 1
+	}
+
+	return m, nil
+}
+
+// card renders the summary of one course
+func (m DashboardModel) card(item dashboardCourse, selected bool) string {
+	borderColor := lipgloss.Color("240")
+	if selected {
+		borderColor = lipgloss.Color("205")
+	}
+	style := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(0, 1).
+		Width(min(m.width-2, 72))
+
+	nameStyle := lipgloss.NewStyle().Bold(true)
+	if selected {
+		nameStyle = nameStyle.Foreground(lipgloss.Color("205"))
+	}
+	dimStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	name := item.course.Name
+	if item.course.CourseCode != "" {
+		name = item.course.CourseCode + " · " + name
+	}
+
+	term := "No term"
+	if item.course.Term != nil && item.course.Term.Name != "" {
+		term = item.course.Term.Name
+	}
+
+	var counts string
+	if item.err != nil {
+		counts = lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Render("Could not load: " + item.err.Error())
+	} else {
+		dueStyle := dimStyle
+		if item.dueCount > 0 {
+			dueStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("226"))
+		}
+		unreadStyle := dimStyle
+		if item.unreadCount > 0 {
+			unreadStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("39"))
+		}
+		counts = dueStyle.Render(fmt.Sprintf("%d due this week", item.dueCount)) + dimStyle.Render(" • ") +
+			unreadStyle.Render(fmt.Sprintf("%d unread announcements", item.unreadCount))
+	}
+
+	width := min(m.width-2, 72) - 2
+	lines := []string{
+		nameStyle.Render(runewidth.Truncate(name, width, "…")),
+		dimStyle.Render(runewidth.Truncate(term, width, "…")),
+		lipgloss.NewStyle().MaxWidth(width).Render(counts),
+	}
+	return style.Render(strings.Join(lines, "\n"))
+}
+
+func (m DashboardModel) View() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("205"))
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Dashboard") + "\n\n")
+
+	if len(m.courses) == 0 {
+		b.WriteString("No active courses.\n")
+	}
+
+	end := min(m.offset+m.visibleCards(), len(m.courses))
+	for i := m.offset; i < end; i++ {
+		b.WriteString(m.card(m.courses[i], i == m.cursor) + "\n")
+	}
+
+	help := "↑/↓: Navigate • enter: View assignments • q: Quit"
+	if len(m.courses) > m.visibleCards() {
+		help = fmt.Sprintf("%d/%d • %s", m.cursor+1, len(m.courses), help)
+	}
+	b.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(help))
+
+	return b.String()
+}
+
+func runDashboard() {
+	client := newClient()
+	courses, err := client.GetCourses()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching courses: %v\n", err)
+		return
+	}
+
+	now := time.Now()
+	var active []api.Course
+	for _, course := range courses {
+		if isActiveCourse(course, now) {
+			active = append(active, course)
+		}
+	}
+
+	// Fetch the assignments and announcements of every course concurrently
+	weekEnd := now.AddDate(0, 0, 7)
+	summaries := make([]dashboardCourse, len(active))
+	sem := make(chan struct{}, maxConcurrency())
+	var wg sync.WaitGroup
+	for i, course := range active {
+		wg.Add(1)
+		go func(i int, course api.Course) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			summaries[i].course = course
+			courseID := strconv.Itoa(course.ID)
+			assignments, err := client.GetAssignments(courseID, 0, 0)
+			if err != nil {
+				summaries[i].err = err
+				return
+			}
+			announcements, err := client.GetAnnouncements(courseID)
+			if err != nil {
+				summaries[i].err = err
+				return
+			}
+
+			for _, assignment := range assignments {
+				if !assignment.DueAt.IsZero() && assignment.DueAt.After(now) && assignment.DueAt.Before(weekEnd) {
+					summaries[i].dueCount++
+				}
+			}
+			for _, announcement := range announcements {
+				if announcement.ReadState == "unread" {
+					summaries[i].unreadCount++
+				}
+			}
+		}(i, course)
+	}
+	wg.Wait()
+
+	rows := []table.Row{}
+	for _, summary := range summaries {
+		term := ""
+		if summary.course.Term != nil {
+			term = summary.course.Term.Name
+		}
+		dueCount, unreadCount := strconv.Itoa(summary.dueCount), strconv.Itoa(summary.unreadCount)
+		if summary.err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not fetch assignments or announcements for %s: %v\n", summary.course.Name, summary.err)
+			dueCount, unreadCount = "", ""
+		}
+		rows = append(rows, table.Row{
+			strconv.Itoa(summary.course.ID),
+			summary.course.CourseCode,
+			summary.course.Name,
+			term,
+			dueCount,
+			unreadCount,
+		})
+	}
+
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Code", Width: 15},
+		{Title: "Name", Width: 35},
+		{Title: "Term", Width: 20},
+		{Title: "Due This Week", Width: 14},
+		{Title: "Unread", Width: 8},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	result, err := runProgram(newDashboardModel(summaries), tea.WithAltScreen())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+
+	if courseID := result.(DashboardModel).selected; courseID != "" {
+		runAssignmentsList(courseID, "", defaultPagination(), 0)
+	}
+}
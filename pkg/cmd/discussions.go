@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// NewDiscussionsCmd creates a new command for managing discussions
+func NewDiscussionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "discussions",
+		Short: "Manage Canvas discussions",
+		Long:  `View and interact with discussion topics in Canvas courses.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newDiscussionsListCmd(),
+		newDiscussionsViewCmd(),
+		newDiscussionsReplyCmd(),
+		newDiscussionsSubscribeCmd(),
+		newDiscussionsUnsubscribeCmd(),
+	)
+
+	return cmd
+}
+
+func newDiscussionsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [course-id]",
+		Short: "List discussion topics in a course",
+		Long:  `List all discussion topics in a Canvas course.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runDiscussionsList(args[0])
+		},
+	}
+}
+
+func newDiscussionsViewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "view [course-id] [topic-id]",
+		Short: "View a discussion topic",
+		Long:  `View a Canvas discussion topic and its threaded replies.`,
+		Args:  cobra.ExactArgs(2),
+		Run:   runDiscussionsView,
+	}
+}
+
+func newDiscussionsReplyCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reply [course-id] [topic-id]",
+		Short: "Reply to a discussion topic",
+		Long:  `Compose and post a reply to a Canvas discussion topic.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runDiscussionsReply(args[0], args[1])
+		},
+	}
+}
+
+func newDiscussionsSubscribeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "subscribe [course-id] [topic-id]",
+		Short: "Subscribe to a discussion topic",
+		Long:  `Subscribe to a discussion topic to receive notifications about new replies.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			courseID := args[0]
+			topicID := args[1]
+
+			client := newClient()
+			if err := client.SubscribeToDiscussion(courseID, topicID); err != nil {
+				fmt.Fprintf(os.Stderr, "Error subscribing to discussion: %v\n", err)
+				return
+			}
+
+			fmt.Printf("Successfully subscribed to discussion %s in course %s\n", topicID, courseID)
+		},
+	}
+}
+
+func newDiscussionsUnsubscribeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unsubscribe [course-id] [topic-id]",
+		Short: "Unsubscribe from a discussion topic",
+		Long:  `Unsubscribe from a discussion topic to stop receiving notifications about new replies.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			courseID := args[0]
+			topicID := args[1]
+
+			client := newClient()
+			if err := client.UnsubscribeFromDiscussion(courseID, topicID); err != nil {
+				fmt.Fprintf(os.Stderr, "Error unsubscribing from discussion: %v\n", err)
+				return
+			}
+
+			fmt.Printf("Successfully unsubscribed from discussion %s in course %s\n", topicID, courseID)
+		},
+	}
+}
+
+func runDiscussionsList(courseID string) {
+	client := newClient()
+	topics, err := client.GetDiscussions(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching discussions: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, topic := range topics {
+		posted := ""
+		if !topic.PostedAt.IsZero() {
+			posted = topic.PostedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
+		}
+
+		rows = append(rows, table.Row{
+			strconv.Itoa(topic.ID),
+			topic.Title,
+			topic.UserName,
+			posted,
+			strconv.Itoa(topic.DiscussionSubentryCount),
+			strconv.Itoa(topic.UnreadCount),
+		})
+	}
+
+	// Create a table for discussion topics
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Title", Width: 35},
+		{Title: "Author", Width: 20},
+		{Title: "Posted", Width: 20},
+		{Title: "Replies", Width: 8},
+		{Title: "Unread", Width: 7},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Discussions for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+	m.EnableFilter(1)
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runDiscussionsView(cmd *cobra.Command, args []string) {
+	courseID := args[0]
+	topicID := args[1]
+
+	client := newClient()
+	topic, err := client.GetDiscussion(courseID, topicID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching discussion: %v\n", err)
+		return
+	}
+
+	entries, err := client.GetDiscussionEntries(courseID, topicID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching discussion entries: %v\n", err)
+		return
+	}
+
+	render := func(width int) string {
+		return formatDiscussion(topic, entries, width)
+	}
+
+	// Print plain text when the output is piped
+	if !stdoutIsTerminal() {
+		fmt.Print(render(80))
+		return
+	}
+
+	model := ui.NewViewportModel("Discussion Details", render)
+	if _, err := runProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running discussion view: %v\n", err)
+	}
+}
+
+// formatDiscussion formats a discussion topic and its threaded entries
+func formatDiscussion(topic *api.DiscussionTopic, entries []api.DiscussionEntry, width int) string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("205")).
+		Bold(true)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("39")).
+		Bold(true).
+		Width(13)
+
+	sectionStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("99")).
+		Bold(true).
+		MarginTop(1).
+		MarginBottom(1)
+
+	posted := "Not set"
+	if !topic.PostedAt.IsZero() {
+		posted = topic.PostedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
+	}
+
+	var content strings.Builder
+	content.WriteString(titleStyle.Render(topic.Title) + "\n\n")
+	content.WriteString(labelStyle.Render("ID:") + strconv.Itoa(topic.ID) + "\n")
+	content.WriteString(labelStyle.Render("Author:") + topic.UserName + "\n")
+	content.WriteString(labelStyle.Render("Posted:") + posted + "\n")
+	content.WriteString(labelStyle.Render("Replies:") + strconv.Itoa(topic.DiscussionSubentryCount) + "\n")
+	content.WriteString(labelStyle.Render("Subscribed:") + yesNo(topic.Subscribed) + "\n")
+
+	if topic.Message != "" {
+		content.WriteString("\n" + ui.RenderHTML(topic.Message, width-6) + "\n")
+	}
+
+	content.WriteString(sectionStyle.Render("Replies") + "\n")
+	if len(entries) == 0 {
+		content.WriteString("No replies yet.\n")
+	}
+	writeDiscussionEntries(&content, entries, 0, width)
+
+	return content.String()
+}
+
+// writeDiscussionEntries writes entries and their replies, indenting each
+// level of the thread
+func writeDiscussionEntries(content *strings.Builder, entries []api.DiscussionEntry, depth, width int) {
+	authorStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("39")).
+		Bold(true)
+	dateStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240"))
+
+	indent := depth * 4
+	// Keep deeply nested replies readable on narrow terminals
+	if indent > width/2 {
+		indent = width / 2
+	}
+	entryStyle := lipgloss.NewStyle().
+		PaddingLeft(indent).
+		Width(width - 6)
+
+	for _, entry := range entries {
+		message := ui.RenderHTML(entry.Message, width-6-indent)
+		if entry.Deleted {
+			message = "(deleted)"
+		}
+
+		header := authorStyle.Render(entry.UserName) + " " + dateStyle.Render(entry.CreatedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM"))
+		content.WriteString(entryStyle.Render(header+"\n"+message) + "\n\n")
+
+		writeDiscussionEntries(content, entry.Replies, depth+1, width)
+	}
+}
+
+func runDiscussionsReply(courseID, topicID string) {
+	var message string
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewText().
+				Title("Reply").
+				Placeholder("Write your reply").
+				Editor("vi").
+				CharLimit(10000).
+				Validate(func(s string) error {
+					if strings.TrimSpace(s) == "" {
+						return fmt.Errorf("reply cannot be empty")
+					}
+					return nil
+				}).
+				Value(&message),
+		),
+	).WithTheme(huh.ThemeBase16())
+
+	if err := form.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	client := newClient()
+	entry, err := client.PostDiscussionEntry(courseID, topicID, message)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error posting reply: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Successfully posted reply %d to discussion %s\n", entry.ID, topicID)
+}
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// dueDateLayout is the format of the Due Date column, which is parsed back to
+// color each row
+const dueDateLayout = "Mon Jan 2, 2006 3:04 PM"
+
+// dueDatesOverdueWindow is how far back past-due assignments are still listed
+const dueDatesOverdueWindow = 7 * 24 * time.Hour
+
+// NewDueDatesCmd creates a new command for listing upcoming due dates
+func NewDueDatesCmd() *cobra.Command {
+	var days int
+
+	cmd := &cobra.Command{
+		Use:   "due-dates",
+		Short: "Show upcoming due dates across your courses",
+		Long: `Show the assignments due in the next few days across all of your active
+courses, soonest first.
+
+Assignments that came due in the last week are also listed, in red.
+Assignments due within 48 hours are shown in yellow and later ones in green.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if days < 1 {
+				fmt.Fprintln(os.Stderr, "Error: --days must be at least 1")
+				return
+			}
+			runDueDates(days)
+		},
+	}
+
+	cmd.Flags().IntVar(&days, "days", 14, "Number of days ahead to show")
+
+	return cmd
+}
+
+// dueAssignment is an assignment along with the course it belongs to
+type dueAssignment struct {
+	course     api.Course
+	assignment api.Assignment
+}
+
+// isActiveCourse reports whether a course is published and has not ended
+func isActiveCourse(course api.Course, now time.Time) bool {
+	if course.Workflow != "available" {
+		return false
+	}
+	return course.EndAt.IsZero() || course.EndAt.After(now)
+}
+
+func runDueDates(days int) {
+	client := newClient()
+	courses, err := client.GetCourses()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching courses: %v\n", err)
+		return
+	}
+
+	now := time.Now()
+	var active []api.Course
+	for _, course := range courses {
+		if isActiveCourse(course, now) {
+			active = append(active, course)
+		}
+	}
+
+	// Fetch the assignments of every course concurrently
+	courseIDs := make([]string, len(active))
+	for i, course := range active {
+		courseIDs[i] = strconv.Itoa(course.ID)
+	}
+	results, errs := client.GetAssignmentsForCourses(courseIDs, maxConcurrency())
+	courseErrs := make(map[string]error, len(errs))
+	for _, err := range errs {
+		var courseErr *api.CourseError
+		if errors.As(err, &courseErr) {
+			courseErrs[courseErr.CourseID] = courseErr.Err
+		}
+	}
+
+	from := now.Add(-dueDatesOverdueWindow)
+	to := now.AddDate(0, 0, days)
+
+	var due []dueAssignment
+	for _, course := range active {
+		courseID := strconv.Itoa(course.ID)
+		if err := courseErrs[courseID]; err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not fetch assignments for %s: %v\n", course.Name, err)
+			continue
+		}
+		for _, assignment := range results[courseID] {
+			if assignment.DueAt.IsZero() || assignment.DueAt.Before(from) || assignment.DueAt.After(to) {
+				continue
+			}
+			due = append(due, dueAssignment{course: course, assignment: assignment})
+		}
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].assignment.DueAt.Before(due[j].assignment.DueAt)
+	})
+
+	rows := []table.Row{}
+	for _, item := range due {
+		rows = append(rows, table.Row{
+			item.course.Name,
+			item.assignment.Name,
+			item.assignment.DueAt.In(config.Location()).Format(dueDateLayout),
+			strconv.FormatFloat(item.assignment.PointsPossible, 'f', -1, 64),
+		})
+	}
+
+	// Create a table for due dates
+	columns := []table.Column{
+		{Title: "Course", Width: 25},
+		{Title: "Assignment", Width: 35},
+		{Title: "Due Date", Width: 24},
+		{Title: "Points", Width: 8},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Due in the Next %d Days", days)
+	m.Help = "↑/↓: Navigate • q: Quit"
+	m.EnableFilter(-1)
+
+	// Color rows by how soon the assignment is due, with later ones in green
+	m.ColorRowFunc = func(row table.Row) lipgloss.Style {
+		dueAt, err := time.ParseInLocation(dueDateLayout, row[2], config.Location())
+		if err != nil {
+			return lipgloss.NewStyle()
+		}
+		if dueAt.After(time.Now().Add(48 * time.Hour)) {
+			return lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+		}
+		return ui.DueDateStyle(dueAt)
+	}
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,197 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/spf13/cobra"
+)
+
+// NewFilesCmd creates a new command for managing course files
+func NewFilesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "files",
+		Short: "Manage Canvas course files",
+		Long:  `List, download, and upload files in Canvas courses.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newFilesListCmd(),
+		newFilesDownloadCmd(),
+		newFilesUploadCmd(),
+	)
+
+	return cmd
+}
+
+func newFilesListCmd() *cobra.Command {
+	var folderID string
+
+	cmd := &cobra.Command{
+		Use:   "list [course-id]",
+		Short: "List files in a course",
+		Long:  `List all files in a Canvas course, or only those in one folder with --folder.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runFilesList(args[0], folderID)
+		},
+	}
+
+	cmd.Flags().StringVar(&folderID, "folder", "", "Only list files in this folder ID")
+
+	return cmd
+}
+
+func newFilesDownloadCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "download [course-id] [file-id] [dest-path]",
+		Short: "Download a file",
+		Long: `Download a file from a Canvas course.
+
+The file is saved under its Canvas name when no destination is given or the
+destination is a directory.`,
+		Args: cobra.RangeArgs(2, 3),
+		Run: func(cmd *cobra.Command, args []string) {
+			dest := "."
+			if len(args) > 2 {
+				dest = args[2]
+			}
+			runFilesDownload(args[0], args[1], dest)
+		},
+	}
+}
+
+func newFilesUploadCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "upload [course-id] [folder-id] [local-path]",
+		Short: "Upload a file",
+		Long: `Upload a local file to a folder in a Canvas course.
+
+Files with the same name as an existing file in the folder are renamed.`,
+		Args: cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			runFilesUpload(args[0], args[1], args[2])
+		},
+	}
+}
+
+func runFilesList(courseID, folderID string) {
+	client := newClient()
+	files, err := client.GetFiles(courseID, folderID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching files: %v\n", err)
+		return
+	}
+
+	// Files only carry their folder ID, so look up the folder paths
+	folders, err := client.GetFolders(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching folders: %v\n", err)
+		return
+	}
+	folderNames := make(map[int]string, len(folders))
+	for _, folder := range folders {
+		folderNames[folder.ID] = folder.FullName
+	}
+
+	rows := []table.Row{}
+	for _, file := range files {
+		rows = append(rows, table.Row{
+			strconv.Itoa(file.ID),
+			file.DisplayName,
+			folderNames[file.FolderID],
+			formatBytes(file.Size),
+			file.UpdatedAt.In(config.Location()).Format("Jan 2, 2006"),
+		})
+	}
+
+	// Create a table for files
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Name", Width: 35},
+		{Title: "Folder", Width: 25},
+		{Title: "Size", Width: 10},
+		{Title: "Updated", Width: 14},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Files for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+	m.EnableFilter(1)
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runFilesDownload(courseID, fileID, dest string) {
+	client := newClient()
+
+	// Save into a directory under the file's Canvas name
+	if info, err := os.Stat(dest); err == nil && info.IsDir() {
+		file, err := client.GetFile(courseID, fileID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching file: %v\n", err)
+			return
+		}
+		dest = filepath.Join(dest, filepath.Base(file.DisplayName))
+	}
+
+	out, err := os.Create(dest)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating file: %v\n", err)
+		return
+	}
+
+	err = client.DownloadFile(fileID, out)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(dest)
+		fmt.Fprintf(os.Stderr, "Error downloading file: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Downloaded file %s to %s\n", fileID, dest)
+}
+
+func runFilesUpload(courseID, folderID, localPath string) {
+	client := newClient()
+	file, err := client.UploadFile(courseID, folderID, localPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error uploading file: %v\n", err)
+		return
+	}
+	// Nothing was uploaded under --dry-run
+	if file == nil {
+		return
+	}
+
+	fmt.Println("\n✅ File uploaded successfully!")
+	fmt.Printf("ID: %d\n", file.ID)
+	fmt.Printf("Name: %s\n", file.DisplayName)
+	fmt.Printf("Size: %s\n", formatBytes(file.Size))
+}
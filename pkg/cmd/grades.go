@@ -0,0 +1,433 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+)
+
+// NewGradesCmd creates a new command for viewing grades
+func NewGradesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grades",
+		Short: "View Canvas grades",
+		Long:  `View course grades and per-assignment scores for students in Canvas.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newGradesListCmd(),
+		newGradesViewCmd(),
+		newGradesExportCmd(),
+	)
+
+	return cmd
+}
+
+func newGradesListCmd() *cobra.Command {
+	var enrollmentType string
+	var gradingPeriod string
+	var watch time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "list [course-id]",
+		Short: "List grades for a course",
+		Long: `List the current and final grades of every student enrolled in a Canvas course.
+
+When the course has grading periods, choose one to see the grades for just
+that period, or pass it with --grading-period.
+
+With --watch, the table is fetched again every 30 seconds, or at the interval
+given with --watch=10s, showing when it was last updated.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runGradesList(args[0], enrollmentType, gradingPeriod, watch)
+		},
+	}
+
+	cmd.Flags().StringVarP(&enrollmentType, "type", "t", "StudentEnrollment",
+		"Enrollment type to list (StudentEnrollment, TeacherEnrollment, TaEnrollment, ObserverEnrollment, DesignerEnrollment)")
+	cmd.Flags().StringVar(&gradingPeriod, "grading-period", "", "Only show grades for the grading period with this ID or title")
+	addWatchFlag(cmd, &watch)
+
+	return cmd
+}
+
+func newGradesViewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "view [course-id] [user-id]",
+		Short: "View a student's grades",
+		Long:  `View a student's score on each assignment in a Canvas course.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runGradesView(args[0], args[1])
+		},
+	}
+
+	return cmd
+}
+
+// formatGradingPeriod formats a grading period's title and dates for the
+// grading period selector
+func newGradesExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export [course-id] [output-file]",
+		Short: "Export the gradebook to CSV",
+		Long: `Export the gradebook of a Canvas course to a CSV file with one row per
+student and one column per assignment holding the student's score, like the
+Export button of the Canvas gradebook. Ungraded submissions are left empty.
+
+Writes to stdout when no output file is given or the output file is "-".`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			outputFile := "-"
+			if len(args) > 1 {
+				outputFile = args[1]
+			}
+			runGradesExport(args[0], outputFile)
+		},
+	}
+	cmd.ValidArgsFunction = completeCourseIDs
+
+	return cmd
+}
+
+func formatGradingPeriod(period api.GradingPeriod) string {
+	if period.StartDate.IsZero() || period.EndDate.IsZero() {
+		return period.Title
+	}
+	return fmt.Sprintf("%s (%s – %s)", period.Title,
+		period.StartDate.In(config.Location()).Format("Jan 2, 2006"),
+		period.EndDate.In(config.Location()).Format("Jan 2, 2006"))
+}
+
+// selectGradingPeriod returns the grading period named by the --grading-period
+// flag, by ID or title. Without the flag, the user chooses one when grades are
+// shown in the interactive table. It returns nil for all grading periods.
+func selectGradingPeriod(client *api.Client, courseID, flag string) (*api.GradingPeriod, error) {
+	interactive := outputFormat == "table" && stdoutIsTerminal()
+	if flag == "" && !interactive {
+		return nil, nil
+	}
+
+	periods, err := client.GetGradingPeriods(courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if flag != "" {
+		for i, period := range periods {
+			if strconv.Itoa(period.ID) == flag || strings.EqualFold(period.Title, flag) {
+				return &periods[i], nil
+			}
+		}
+		return nil, fmt.Errorf("course %s has no grading period %q", courseID, flag)
+	}
+
+	if len(periods) == 0 {
+		return nil, nil
+	}
+
+	// Default to the period that is underway
+	selected := -1
+	now := time.Now()
+	options := []huh.Option[int]{huh.NewOption("All grading periods", -1)}
+	for i, period := range periods {
+		options = append(options, huh.NewOption(formatGradingPeriod(period), i))
+		if now.After(period.StartDate) && now.Before(period.EndDate) {
+			selected = i
+		}
+	}
+
+	err = huh.NewSelect[int]().
+		Title(fmt.Sprintf("Grading Period for Course %s", courseID)).
+		Options(options...).
+		Value(&selected).
+		WithTheme(huh.ThemeBase16()).
+		Run()
+	if err != nil {
+		return nil, err
+	}
+	if selected < 0 {
+		return nil, nil
+	}
+	return &periods[selected], nil
+}
+
+// fetchGradeRows fetches the grades of the enrollments of enrollmentType,
+// for the grading period when one is given
+func fetchGradeRows(client *api.Client, courseID, enrollmentType string, period *api.GradingPeriod) ([]table.Row, error) {
+	var enrollments []api.Enrollment
+	var err error
+	if period != nil {
+		enrollments, err = client.GetEnrollmentsForGradingPeriod(courseID, strconv.Itoa(period.ID))
+	} else {
+		enrollments, err = client.GetEnrollments(courseID, 0, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []table.Row{}
+	for _, enrollment := range enrollments {
+		if enrollment.Type != enrollmentType {
+			continue
+		}
+
+		rows = append(rows, table.Row{
+			enrollment.User.Name,
+			enrollment.User.Email,
+			enrollment.Grades.CurrentGrade,
+			enrollment.Grades.FinalGrade,
+			strconv.FormatFloat(enrollment.Grades.CurrentScore, 'f', -1, 64),
+			strconv.FormatFloat(enrollment.Grades.FinalScore, 'f', -1, 64),
+		})
+	}
+	return rows, nil
+}
+
+func runGradesList(courseID, enrollmentType, gradingPeriod string, watch time.Duration) {
+	client := newClient()
+	period, err := selectGradingPeriod(client, courseID, gradingPeriod)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error selecting grading period: %v\n", err)
+		return
+	}
+
+	rows, err := fetchGradeRows(client, courseID, enrollmentType, period)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching enrollments: %v\n", err)
+		return
+	}
+
+	// Create a table for grades
+	columns := []table.Column{
+		{Title: "Student", Width: 25},
+		{Title: "Email", Width: 30},
+		{Title: "Current Grade", Width: 14},
+		{Title: "Final Grade", Width: 12},
+		{Title: "Current Score", Width: 14},
+		{Title: "Final Score", Width: 12},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	if len(rows) == 0 {
+		fmt.Printf("No %s enrollments found for this course.\n", enrollmentType)
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Grades for Course %s", courseID)
+	if period != nil {
+		m.Title += " • " + period.Title
+	}
+	m.Help = "↑/↓: Navigate • q: Quit"
+	watchTable(m, client, watch, func(int) ([]table.Row, error) {
+		return fetchGradeRows(client, courseID, enrollmentType, period)
+	})
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runGradesView(courseID, userID string) {
+	client := newClient()
+	submissions, err := client.GetStudentSubmissions(courseID, userID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching grades: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, submission := range submissions {
+		rows = append(rows, gradeRow(submission))
+	}
+
+	// Create a table for assignment scores
+	columns := []table.Column{
+		{Title: "Assignment ID", Width: 14},
+		{Title: "Assignment", Width: 30},
+		{Title: "Due Date", Width: 20},
+		{Title: "Score", Width: 8},
+		{Title: "Points", Width: 8},
+		{Title: "Grade", Width: 10},
+		{Title: "Status", Width: 12},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	if len(rows) == 0 {
+		fmt.Println("No assignments found for this student.")
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Grades for User %s in Course %s", userID, courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// gradeRow builds the table row for one of a student's submissions
+func gradeRow(submission api.Submission) table.Row {
+	name := ""
+	dueDate := "No due date"
+	points := ""
+	if assignment := submission.Assignment; assignment != nil {
+		name = assignment.Name
+		if !assignment.DueAt.IsZero() {
+			dueDate = assignment.DueAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
+		}
+		points = strconv.FormatFloat(assignment.PointsPossible, 'f', -1, 64)
+	}
+
+	// Only graded submissions have a meaningful score
+	score := "-"
+	if submission.Grade != "" {
+		score = strconv.FormatFloat(submission.Score, 'f', -1, 64)
+	}
+
+	status := submission.WorkflowState
+	switch {
+	case submission.Excused:
+		status = "excused"
+	case submission.Missing:
+		status = "missing"
+	case submission.Late:
+		status = "late"
+	}
+
+	return table.Row{
+		strconv.Itoa(submission.AssignmentID),
+		name,
+		dueDate,
+		score,
+		points,
+		submission.Grade,
+		status,
+	}
+}
+
+func runGradesExport(courseID, outputFile string) {
+	client := newClient()
+	users, err := client.GetUsers(courseID, 0, 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching users: %v\n", err)
+		return
+	}
+
+	assignments, err := client.GetAssignments(courseID, 0, 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching assignments: %v\n", err)
+		return
+	}
+
+	submissions, err := client.GetCourseSubmissions(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching submissions: %v\n", err)
+		return
+	}
+
+	// scores[user][assignment] holds the score of each graded submission
+	scores := make(map[int]map[int]string)
+	for _, submission := range submissions {
+		if scores[submission.UserID] == nil {
+			scores[submission.UserID] = make(map[int]string)
+		}
+		if submission.Grade != "" && !submission.Excused {
+			scores[submission.UserID][submission.AssignmentID] = strconv.FormatFloat(submission.Score, 'f', -1, 64)
+		}
+	}
+
+	out := os.Stdout
+	if outputFile != "-" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating output file: %v\n", err)
+			return
+		}
+		defer f.Close()
+		out = f
+	}
+
+	w := csv.NewWriter(out)
+
+	// Assignment names aren't unique, so include the ID like Canvas does
+	header := []string{"ID", "Name", "Email"}
+	for _, assignment := range assignments {
+		header = append(header, fmt.Sprintf("%s (%d)", assignment.Name, assignment.ID))
+	}
+	if err := w.Write(header); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing CSV: %v\n", err)
+		return
+	}
+
+	students := 0
+	for _, user := range users {
+		// Canvas only returns submissions for students, which leaves out
+		// teachers, TAs, and observers
+		userScores, ok := scores[user.ID]
+		if !ok {
+			continue
+		}
+
+		record := []string{strconv.Itoa(user.ID), user.Name, user.Email}
+		for _, assignment := range assignments {
+			record = append(record, userScores[assignment.ID])
+		}
+		if err := w.Write(record); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing CSV: %v\n", err)
+			return
+		}
+		students++
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing CSV: %v\n", err)
+		return
+	}
+
+	if outputFile != "-" {
+		fmt.Printf("Exported %d students to %s\n", students, outputFile)
+	}
+}
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	lgtable "github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+)
+
+func newCoursesGradingStandardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grading-standards",
+		Short: "View a course's grading scales",
+		Long:  `View the grading standards that map scores to letter grades or GPA values in a course.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(
+		newGradingStandardsListCmd(),
+		newGradingStandardsViewCmd(),
+	)
+
+	return cmd
+}
+
+func newGradingStandardsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [course-id]",
+		Short: "List grading standards",
+		Long:  `List every grade of the grading standards available to a course, with the minimum percentage for each grade.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runGradingStandardsList(args[0])
+		},
+	}
+}
+
+func newGradingStandardsViewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "view [course-id] [standard-id]",
+		Short: "View a grading standard",
+		Long:  `View the full scale of a grading standard, with the range of scores for each grade.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runGradingStandardsView(args[0], args[1])
+		},
+	}
+}
+
+// sortedGradingScheme returns the grades of a standard from highest to lowest
+func sortedGradingScheme(standard *api.GradingStandard) []api.GradingSchemeEntry {
+	entries := append([]api.GradingSchemeEntry(nil), standard.GradingScheme...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Value > entries[j].Value
+	})
+	return entries
+}
+
+func runGradingStandardsList(courseID string) {
+	standards, err := newClient().GetGradingStandards(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching grading standards: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for i := range standards {
+		standard := &standards[i]
+		for _, entry := range sortedGradingScheme(standard) {
+			rows = append(rows, table.Row{
+				strconv.Itoa(standard.ID),
+				standard.Title,
+				standard.ContextType,
+				entry.Name,
+				formatStat(entry.Value*100) + "%",
+			})
+		}
+	}
+
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Standard", Width: 30},
+		{Title: "Context", Width: 10},
+		{Title: "Grade", Width: 10},
+		{Title: "Minimum", Width: 10},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	if len(standards) == 0 {
+		fmt.Println("No grading standards found for this course.")
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Grading Standards for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+	m.EnableFilter(-1)
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// formatGradingStandard formats a grading standard with the range of scores
+// for each grade
+func formatGradingStandard(standard *api.GradingStandard) string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("205")).
+		Bold(true)
+
+	var content strings.Builder
+	content.WriteString(titleStyle.Render(standard.Title) + "\n")
+	scale := "Percentage scale"
+	if standard.PointsBased {
+		scale = fmt.Sprintf("Points-based scale out of %s", formatStat(standard.ScalingFactor))
+	}
+	content.WriteString(fmt.Sprintf("%s %d • %s • %d grades\n\n",
+		standard.ContextType, standard.ContextID, scale, len(standard.GradingScheme)))
+
+	headers := []string{"Grade", "From", "To"}
+	if standard.PointsBased {
+		headers = append(headers, "Points")
+	}
+	grades := lgtable.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("240"))).
+		Headers(headers...).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			return lipgloss.NewStyle().Padding(0, 1)
+		})
+
+	// Each grade runs from its minimum up to the minimum of the grade above
+	upper := "100%"
+	for _, entry := range sortedGradingScheme(standard) {
+		row := []string{entry.Name, formatStat(entry.Value*100) + "%", upper}
+		if standard.PointsBased {
+			row = append(row, formatStat(entry.Value*standard.ScalingFactor))
+		}
+		grades.Row(row...)
+		upper = "< " + formatStat(entry.Value*100) + "%"
+	}
+	content.WriteString(grades.Render() + "\n")
+
+	return content.String()
+}
+
+func runGradingStandardsView(courseID, standardID string) {
+	standard, err := newClient().GetGradingStandard(courseID, standardID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching grading standard: %v\n", err)
+		return
+	}
+
+	render := func(width int) string {
+		return formatGradingStandard(standard)
+	}
+
+	// Print plain text when the output is piped
+	if !stdoutIsTerminal() {
+		fmt.Println(render(80))
+		return
+	}
+
+	model := ui.NewViewportModel("Grading Standard", render)
+	if _, err := runProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running grading standard view: %v\n", err)
+	}
+}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/spf13/cobra"
+)
+
+// NewGroupsCmd creates a new command for viewing course groups
+func NewGroupsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups",
+		Short: "View Canvas groups",
+		Long:  `List the groups in Canvas courses and their members.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newGroupsListCmd(),
+		newGroupsMembersCmd(),
+	)
+
+	return cmd
+}
+
+func newGroupsListCmd() *cobra.Command {
+	var category string
+
+	cmd := &cobra.Command{
+		Use:   "list [course-id]",
+		Short: "List groups in a course",
+		Long:  `List the groups in a Canvas course with their category and member count.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runGroupsList(args[0], category)
+		},
+	}
+
+	cmd.Flags().StringVar(&category, "group-category", "", "Only list groups in this category, by ID or name")
+
+	return cmd
+}
+
+func newGroupsMembersCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "members [group-id]",
+		Short: "List members of a group",
+		Long:  `List the users who belong to a Canvas group.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runGroupsMembers(args[0])
+		},
+	}
+}
+
+func runGroupsList(courseID, category string) {
+	client := newClient()
+	groups, err := client.GetGroups(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching groups: %v\n", err)
+		return
+	}
+
+	// Groups only carry their category ID, so look up the names
+	categories, err := client.GetGroupCategories(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching group categories: %v\n", err)
+		return
+	}
+	categoryNames := make(map[int]string, len(categories))
+	for _, c := range categories {
+		categoryNames[c.ID] = c.Name
+	}
+
+	rows := []table.Row{}
+	for _, group := range groups {
+		categoryName := categoryNames[group.GroupCategoryID]
+		if category != "" && category != strconv.Itoa(group.GroupCategoryID) && !strings.EqualFold(category, categoryName) {
+			continue
+		}
+
+		rows = append(rows, table.Row{
+			strconv.Itoa(group.ID),
+			group.Name,
+			categoryName,
+			strconv.Itoa(group.MembersCount),
+		})
+	}
+
+	// Create a table for groups
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Name", Width: 30},
+		{Title: "Category", Width: 25},
+		{Title: "Members", Width: 8},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	if len(rows) == 0 {
+		fmt.Println("No groups found for this course.")
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Groups for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+	m.EnableFilter(1)
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runGroupsMembers(groupID string) {
+	client := newClient()
+	users, err := client.GetGroupMembers(groupID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching group members: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, user := range users {
+		rows = append(rows, table.Row{
+			strconv.Itoa(user.ID),
+			user.Name,
+			user.Email,
+			user.LoginID,
+		})
+	}
+
+	// Create a table for group members
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Name", Width: 30},
+		{Title: "Email", Width: 30},
+		{Title: "Login ID", Width: 20},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Members of Group %s", groupID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
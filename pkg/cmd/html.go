@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	// htmlBreakPattern matches tags that end a line of text
+	htmlBreakPattern = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|li|h[1-6]|tr|blockquote)>`)
+	// htmlTagPattern matches any other tag
+	htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+	// blankLinesPattern matches runs of more than one blank line
+	blankLinesPattern = regexp.MustCompile(`\n{3,}`)
+)
+
+// stripHTML converts Canvas HTML content to plain text for display in the terminal
+func stripHTML(s string) string {
+	s = htmlBreakPattern.ReplaceAllString(s, "\n")
+	s = htmlTagPattern.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	s = strings.Join(lines, "\n")
+
+	return strings.TrimSpace(blankLinesPattern.ReplaceAllString(s, "\n\n"))
+}
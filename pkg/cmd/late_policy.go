@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+func newCoursesLatePolicyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "late-policy",
+		Short: "View and set a course's late policy",
+		Long:  `View or change the points a course automatically deducts from late and missing submissions.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(
+		newLatePolicyViewCmd(),
+		newLatePolicySetCmd(),
+	)
+
+	return cmd
+}
+
+func newLatePolicyViewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "view [course-id]",
+		Short: "View a course's late policy",
+		Long:  `Show the deductions a course applies to late and missing submissions.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runLatePolicyView(args[0])
+		},
+	}
+}
+
+func newLatePolicySetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set [course-id]",
+		Short: "Set a course's late policy",
+		Long: `Configure the deductions for missing and late submissions in a form,
+pre-filled with the course's current policy.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runLatePolicySet(args[0])
+		},
+	}
+}
+
+// formatPercent formats a deduction percentage without trailing zeros
+func formatPercent(percent float64) string {
+	return strconv.FormatFloat(percent, 'f', -1, 64) + "%"
+}
+
+// describeLatePolicy returns the missing and late submission deductions of a
+// policy as sentences
+func describeLatePolicy(policy *api.LatePolicy) (missing, late string) {
+	missing = "No deduction"
+	if policy.MissingSubmissionDeductionEnabled {
+		missing = fmt.Sprintf("Deduct %s of the points possible", formatPercent(policy.MissingSubmissionDeduction))
+	}
+
+	late = "No deduction"
+	if policy.LateSubmissionDeductionEnabled {
+		late = fmt.Sprintf("Deduct %s per %s late", formatPercent(policy.LateSubmissionDeduction), policy.LateSubmissionInterval)
+		if policy.LateSubmissionMinimumPercentEnabled {
+			late += fmt.Sprintf(", keeping at least %s of the score", formatPercent(policy.LateSubmissionMinimumPercent))
+		}
+	}
+	return missing, late
+}
+
+func runLatePolicyView(courseID string) {
+	policy, err := newClient().GetLatePolicy(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching late policy: %v\n", err)
+		return
+	}
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("205"))
+	labelStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39"))
+
+	missing, late := describeLatePolicy(policy)
+	fmt.Println(titleStyle.Render(fmt.Sprintf("Late Policy for Course %s", courseID)))
+	fmt.Println()
+	fmt.Printf("%s %s\n", labelStyle.Render("Missing submissions:"), missing)
+	fmt.Printf("%s %s\n", labelStyle.Render("Late submissions:"), late)
+}
+
+// validatePercent checks that a form field is a percentage from 0 to 100
+func validatePercent(s string) error {
+	percent, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("must be a number")
+	}
+	if percent < 0 || percent > 100 {
+		return fmt.Errorf("must be between 0 and 100")
+	}
+	return nil
+}
+
+func runLatePolicySet(courseID string) {
+	client := newClient()
+	policy, err := client.GetLatePolicy(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching late policy: %v\n", err)
+		return
+	}
+
+	missingDeduction := strconv.FormatFloat(policy.MissingSubmissionDeduction, 'f', -1, 64)
+	lateDeduction := strconv.FormatFloat(policy.LateSubmissionDeduction, 'f', -1, 64)
+	interval := policy.LateSubmissionInterval
+	if interval == "" {
+		interval = "day"
+	}
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewNote().
+				Title(fmt.Sprintf("Late Policy for Course %s", courseID)).
+				Description("Deductions are a percentage of the points possible"),
+
+			huh.NewConfirm().
+				Title("Deduct From Missing Submissions").
+				Value(&policy.MissingSubmissionDeductionEnabled),
+
+			huh.NewInput().
+				Title("Missing Submission Deduction (%)").
+				Prompt("> ").
+				Validate(validatePercent).
+				Value(&missingDeduction),
+
+			huh.NewConfirm().
+				Title("Deduct From Late Submissions").
+				Value(&policy.LateSubmissionDeductionEnabled),
+
+			huh.NewInput().
+				Title("Late Submission Deduction (%)").
+				Prompt("> ").
+				Description("Deducted for each interval a submission is late").
+				Validate(validatePercent).
+				Value(&lateDeduction),
+
+			huh.NewSelect[string]().
+				Title("Deduction Interval").
+				Options(
+					huh.NewOption("Per day", "day"),
+					huh.NewOption("Per hour", "hour"),
+				).
+				Value(&interval),
+		),
+	).WithTheme(huh.ThemeBase16())
+
+	if err := form.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	// The inputs were validated by the form
+	policy.MissingSubmissionDeduction, _ = strconv.ParseFloat(missingDeduction, 64)
+	policy.LateSubmissionDeduction, _ = strconv.ParseFloat(lateDeduction, 64)
+	policy.LateSubmissionInterval = interval
+
+	if err := client.UpdateLatePolicy(courseID, policy); err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating late policy: %v\n", err)
+		return
+	}
+
+	missing, late := describeLatePolicy(policy)
+	fmt.Printf("Successfully updated the late policy of course %s\n", courseID)
+	fmt.Printf("  Missing submissions: %s\n", missing)
+	fmt.Printf("  Late submissions: %s\n", late)
+}
@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/spf13/cobra"
+)
+
+// NewModulesCmd creates a new command for managing course modules
+func NewModulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "modules",
+		Short: "Manage Canvas modules",
+		Long:  `List, publish, and manage modules in Canvas courses.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newModulesListCmd(),
+		newModulesPublishCmd(true),
+		newModulesPublishCmd(false),
+		newModuleItemsCmd(),
+	)
+
+	return cmd
+}
+
+func newModulesListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [course-id]",
+		Short: "List modules in a course",
+		Long:  `List all modules in a Canvas course.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runModulesList(args[0])
+		},
+	}
+}
+
+// newModulesPublishCmd creates the publish or unpublish command for modules
+func newModulesPublishCmd(published bool) *cobra.Command {
+	var all bool
+
+	action := "unpublish"
+	if published {
+		action = "publish"
+	}
+
+	cmd := &cobra.Command{
+		Use:   action + " [course-id] [module-id]",
+		Short: fmt.Sprintf("%s a module", capitalize(action)),
+		Long:  fmt.Sprintf("%s a module in a Canvas course, or every module with --all.", capitalize(action)),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if all {
+				return cobra.ExactArgs(1)(cmd, args)
+			}
+			return cobra.ExactArgs(2)(cmd, args)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			courseID := args[0]
+			client := newClient()
+
+			if !all {
+				moduleID := args[1]
+				if err := client.UpdateModulePublishedState(courseID, moduleID, published); err != nil {
+					fmt.Fprintf(os.Stderr, "Error trying to %s module: %v\n", action, err)
+					return
+				}
+				fmt.Printf("Successfully %sed module %s in course %s\n", action, moduleID, courseID)
+				return
+			}
+
+			modules, err := client.GetModules(courseID)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error fetching modules: %v\n", err)
+				return
+			}
+
+			var success, failed int
+			for _, module := range modules {
+				if module.Published == published {
+					continue
+				}
+				if err := client.UpdateModulePublishedState(courseID, strconv.Itoa(module.ID), published); err != nil {
+					fmt.Fprintf(os.Stderr, "Error trying to %s module %q: %v\n", action, module.Name, err)
+					failed++
+					continue
+				}
+				fmt.Printf("%sed module %q\n", capitalize(action), module.Name)
+				success++
+			}
+
+			fmt.Printf("\n✅ Success: %d\n", success)
+			fmt.Printf("❌ Failed: %d\n", failed)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&all, "all", "a", false, fmt.Sprintf("%s every module in the course", capitalize(action)))
+
+	return cmd
+}
+
+func newModuleItemsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "items [course-id] [module-id]",
+		Short: "List and manage module items",
+		Long: `List the items within a Canvas module, or publish and unpublish them.
+
+Select an item in the list to show its details and URL.`,
+		Args: cobra.MaximumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmd.Help()
+				return
+			}
+			if len(args) != 2 {
+				fmt.Fprintln(os.Stderr, "Error: items requires a course ID and a module ID")
+				return
+			}
+			runModuleItemsList(args[0], args[1])
+		},
+	}
+
+	cmd.AddCommand(
+		newModuleItemsPublishCmd(true),
+		newModuleItemsPublishCmd(false),
+	)
+
+	return cmd
+}
+
+// newModuleItemsPublishCmd creates the publish or unpublish command for module items
+func newModuleItemsPublishCmd(published bool) *cobra.Command {
+	action := "unpublish"
+	if published {
+		action = "publish"
+	}
+
+	return &cobra.Command{
+		Use:   action + " [course-id] [module-id] [item-id]",
+		Short: fmt.Sprintf("%s a module item", capitalize(action)),
+		Long:  fmt.Sprintf("%s a single item within a Canvas module.", capitalize(action)),
+		Args:  cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			courseID := args[0]
+			moduleID := args[1]
+			itemID := args[2]
+
+			client := newClient()
+			if err := client.UpdateModuleItemPublishedState(courseID, moduleID, itemID, published); err != nil {
+				fmt.Fprintf(os.Stderr, "Error trying to %s module item: %v\n", action, err)
+				return
+			}
+
+			fmt.Printf("Successfully %sed item %s in module %s\n", action, itemID, moduleID)
+		},
+	}
+}
+
+func runModulesList(courseID string) {
+	client := newClient()
+	modules, err := client.GetModules(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching modules: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, module := range modules {
+		rows = append(rows, table.Row{
+			strconv.Itoa(module.ID),
+			strconv.Itoa(module.Position),
+			module.Name,
+			strconv.Itoa(module.ItemCount),
+			yesNo(module.Published),
+		})
+	}
+
+	// Create a table for modules
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Position", Width: 8},
+		{Title: "Name", Width: 40},
+		{Title: "Items", Width: 6},
+		{Title: "Published", Width: 10},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Modules for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runModuleItemsList(courseID, moduleID string) {
+	client := newClient()
+	items, err := client.GetModuleItems(courseID, moduleID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching module items: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, item := range items {
+		rows = append(rows, table.Row{
+			strconv.Itoa(item.ID),
+			item.Type,
+			item.Title,
+			item.CompletionRequirement.Type,
+			yesNo(item.Published),
+		})
+	}
+
+	// Create a table for module items
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Type", Width: 15},
+		{Title: "Title", Width: 40},
+		{Title: "Requirement", Width: 15},
+		{Title: "Published", Width: 10},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Items in Module %s", moduleID)
+	m.Help = "↑/↓: Navigate • enter: Show URL • q: Quit"
+
+	// Remember the selected item so its details can be printed after the table closes
+	var selected *api.ModuleItem
+	m.QuitOnSelect = true
+	m.OnSelect = func(row table.Row) {
+		for i := range items {
+			if strconv.Itoa(items[i].ID) == row[0] {
+				selected = &items[i]
+				return
+			}
+		}
+	}
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+
+	if selected != nil {
+		printModuleItem(selected)
+	}
+}
+
+// printModuleItem prints the details of a module item
+func printModuleItem(item *api.ModuleItem) {
+	fmt.Printf("\nModule Item Details:\n")
+	fmt.Printf("--------------------\n")
+	fmt.Printf("ID:        %d\n", item.ID)
+	fmt.Printf("Title:     %s\n", item.Title)
+	fmt.Printf("Type:      %s\n", item.Type)
+	if item.ContentID != 0 {
+		fmt.Printf("Content:   %d\n", item.ContentID)
+	}
+	if item.CompletionRequirement.Type != "" {
+		fmt.Printf("Requires:  %s\n", item.CompletionRequirement.Type)
+	}
+	if item.HTMLURL != "" {
+		fmt.Printf("URL:       %s\n", ui.Hyperlink(item.HTMLURL, item.HTMLURL))
+	}
+}
+
+// capitalize upper-cases the first letter of s
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
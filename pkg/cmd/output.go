@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// outputFormat holds the --output flag
+var outputFormat = "table"
+
+// stdoutIsTerminal reports whether stdout is a terminal rather than a pipe or file
+func stdoutIsTerminal() bool {
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// writeOutput writes rows to stdout in the --output format and reports
+// whether it did. Table output to a terminal is left to the caller, which
+// shows the interactive table instead.
+func writeOutput(columns []table.Column, rows []table.Row) bool {
+	if outputFormat == "table" && stdoutIsTerminal() {
+		return false
+	}
+
+	writer, err := ui.NewOutputWriter(outputFormat, os.Stdout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return true
+	}
+
+	titles := make([]string, len(columns))
+	for i, column := range columns {
+		titles[i] = column.Title
+	}
+	data := make([][]string, len(rows))
+	for i, row := range rows {
+		data[i] = row
+	}
+
+	if err := writer.Write(titles, data); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing output: %v\n", err)
+	}
+	return true
+}
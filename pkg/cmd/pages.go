@@ -0,0 +1,414 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// maxPageEditLines is the most lines a huh text field holds; longer bodies
+// would be truncated by the edit form
+const maxPageEditLines = 99
+
+// NewPagesCmd creates a new command for managing wiki pages
+func NewPagesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pages",
+		Short: "Manage Canvas pages",
+		Long:  `List and manage wiki pages in Canvas courses.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newPagesListCmd(),
+		newPagesViewCmd(),
+		newPagesEditCmd(),
+		newPagesBulkUpdateCmd(),
+	)
+
+	return cmd
+}
+
+func newPagesListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [course-id]",
+		Short: "List pages in a course",
+		Long:  `List all wiki pages in a Canvas course.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runPagesList(args[0])
+		},
+	}
+}
+
+func newPagesViewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "view [course-id] [page-url]",
+		Short: "View a page",
+		Long:  `View the contents of a Canvas wiki page as plain text.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runPagesView(args[0], args[1])
+		},
+	}
+}
+
+func newPagesEditCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "edit [course-id] [page-url]",
+		Short: "Edit a page",
+		Long: `Edit the title and HTML body of a Canvas wiki page.
+
+Press ctrl+e in the body field to edit it in vi.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runPagesEdit(args[0], args[1])
+		},
+	}
+}
+
+func newPagesBulkUpdateCmd() *cobra.Command {
+	var publish bool
+	var unpublish bool
+
+	cmd := &cobra.Command{
+		Use:     "bulk-update [course-id]",
+		Aliases: []string{"bulk-update-published"},
+		Short:   "Publish or unpublish all pages in a course",
+		Long: `Publish or unpublish every page in a Canvas course that is not already in the target state.
+
+With --dry-run, list the pages that would change without modifying them.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if publish == unpublish {
+				fmt.Fprintln(os.Stderr, "Error: exactly one of --publish or --unpublish is required")
+				return
+			}
+			runPagesBulkUpdate(args[0], publish)
+		},
+	}
+
+	cmd.Flags().BoolVar(&publish, "publish", false, "Publish all unpublished pages")
+	cmd.Flags().BoolVar(&unpublish, "unpublish", false, "Unpublish all published pages")
+
+	return cmd
+}
+
+// PageUpdateModel represents the model for bulk updating page published state
+type PageUpdateModel struct {
+	courseID    string
+	pages       []api.Page
+	published   bool
+	client      *api.Client
+	progress    int
+	success     int
+	failed      []string
+	current     string
+	completed   bool
+	progressBar progress.Model
+}
+
+// Message type for processing the next page
+type pageUpdateMsg struct {
+	index int
+}
+
+func (m PageUpdateModel) Init() tea.Cmd {
+	return func() tea.Msg {
+		return pageUpdateMsg{index: 0}
+	}
+}
+
+func (m PageUpdateModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c", "esc":
+			return m, tea.Quit
+		}
+	case pageUpdateMsg:
+		page := m.pages[msg.index]
+		m.current = page.Title
+
+		if _, err := m.client.SetPagePublished(m.courseID, page.URL, m.published); err != nil {
+			m.failed = append(m.failed, fmt.Sprintf("%s: %v", page.Title, err))
+		} else {
+			m.success++
+		}
+		m.progress++
+
+		if m.progress < len(m.pages) {
+			return m, func() tea.Msg {
+				return pageUpdateMsg{index: msg.index + 1}
+			}
+		}
+
+		m.completed = true
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+func (m PageUpdateModel) View() string {
+	if m.completed {
+		return ""
+	}
+
+	action := "Unpublishing"
+	if m.published {
+		action = "Publishing"
+	}
+
+	percent := float64(m.progress) / float64(len(m.pages))
+
+	s := fmt.Sprintf("\n%s %d pages in course %s\n\n", action, len(m.pages), m.courseID)
+	s += m.progressBar.ViewAs(percent) + "\n"
+	s += fmt.Sprintf("%d/%d (%d%%)\n", m.progress, len(m.pages), int(percent*100))
+
+	if m.current != "" {
+		s += "\nProcessing: " + m.current + "\n"
+	}
+
+	return s
+}
+
+// summary returns the final results of the bulk update
+func (m PageUpdateModel) summary() string {
+	var results strings.Builder
+	results.WriteString(fmt.Sprintf("\nUpdated %d of %d pages in course %s\n\n", m.success, len(m.pages), m.courseID))
+	results.WriteString(fmt.Sprintf("✅ Success: %d\n", m.success))
+	results.WriteString(fmt.Sprintf("❌ Failed: %d\n", len(m.failed)))
+	for _, failure := range m.failed {
+		results.WriteString("   " + failure + "\n")
+	}
+	return results.String()
+}
+
+func runPagesBulkUpdate(courseID string, published bool) {
+	client := newClient()
+	pages, err := client.GetPages(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching pages: %v\n", err)
+		return
+	}
+
+	// Only touch pages that are not already in the target state
+	var targets []api.Page
+	for _, page := range pages {
+		if page.Published != published {
+			targets = append(targets, page)
+		}
+	}
+
+	action := "unpublish"
+	if published {
+		action = "publish"
+	}
+
+	if len(targets) == 0 {
+		fmt.Printf("No pages to %s in course %s.\n", action, courseID)
+		return
+	}
+
+	if api.DryRun {
+		fmt.Printf("Would %s %d pages in course %s:\n", action, len(targets), courseID)
+		for _, page := range targets {
+			fmt.Printf("  %s (%s)\n", page.Title, page.URL)
+		}
+		return
+	}
+
+	model := PageUpdateModel{
+		courseID:  courseID,
+		pages:     targets,
+		published: published,
+		client:    client,
+		progressBar: progress.New(
+			progress.WithDefaultGradient(),
+			progress.WithWidth(40),
+			progress.WithoutPercentage(),
+		),
+	}
+
+	result, err := runProgram(model)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		return
+	}
+
+	if finalModel, ok := result.(PageUpdateModel); ok {
+		fmt.Print(finalModel.summary())
+	}
+}
+
+func runPagesList(courseID string) {
+	client := newClient()
+	pages, err := client.GetPages(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching pages: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, page := range pages {
+		rows = append(rows, table.Row{
+			page.Title,
+			page.URL,
+			page.UpdatedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM"),
+			page.LastEditedBy.DisplayName,
+			yesNo(page.Published),
+		})
+	}
+
+	// Create a table for pages
+	columns := []table.Column{
+		{Title: "Title", Width: 30},
+		{Title: "URL", Width: 30},
+		{Title: "Last Edited", Width: 20},
+		{Title: "Edited By", Width: 20},
+		{Title: "Published", Width: 10},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Pages for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+	m.EnableFilter(0)
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runPagesView(courseID, pageURL string) {
+	client := newClient()
+	page, err := client.GetPage(courseID, pageURL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching page: %v\n", err)
+		return
+	}
+
+	render := func(width int) string {
+		return formatPage(page, width)
+	}
+
+	// Print plain text when the output is piped
+	if !stdoutIsTerminal() {
+		fmt.Println(render(80))
+		return
+	}
+
+	model := ui.NewViewportModel("Page", render)
+	if _, err := runProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running page view: %v\n", err)
+	}
+}
+
+// formatPage formats a wiki page with its body rendered from HTML
+func formatPage(page *api.Page, width int) string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("205")).
+		Bold(true)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("39")).
+		Bold(true).
+		Width(13)
+
+	edited := page.UpdatedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
+	if page.LastEditedBy.DisplayName != "" {
+		edited += " by " + page.LastEditedBy.DisplayName
+	}
+
+	var content strings.Builder
+	content.WriteString(titleStyle.Render(page.Title) + "\n\n")
+	content.WriteString(labelStyle.Render("URL:") + page.URL + "\n")
+	content.WriteString(labelStyle.Render("Last Edited:") + edited + "\n")
+	content.WriteString(labelStyle.Render("Published:") + yesNo(page.Published) + "\n\n")
+	content.WriteString(ui.RenderHTML(page.Body, width-6) + "\n")
+
+	return content.String()
+}
+
+func runPagesEdit(courseID, pageURL string) {
+	client := newClient()
+	page, err := client.GetPage(courseID, pageURL)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching page: %v\n", err)
+		return
+	}
+
+	if lines := strings.Count(page.Body, "\n") + 1; lines > maxPageEditLines {
+		fmt.Fprintf(os.Stderr, "Error: the page body has %d lines, more than the %d the editor can hold\n", lines, maxPageEditLines)
+		return
+	}
+
+	title := page.Title
+	body := page.Body
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Title").
+				Prompt("> ").
+				Validate(func(s string) error {
+					if strings.TrimSpace(s) == "" {
+						return fmt.Errorf("title is required")
+					}
+					return nil
+				}).
+				Value(&title),
+
+			huh.NewText().
+				Title("Body (HTML)").
+				Editor("vi").
+				CharLimit(0).
+				Lines(15).
+				Value(&body),
+		),
+	).WithTheme(huh.ThemeBase16())
+
+	if err := form.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	if title == page.Title && body == page.Body {
+		fmt.Println("No changes to save.")
+		return
+	}
+
+	updated, err := client.UpdatePage(courseID, pageURL, title, body)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating page: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Successfully updated page %q (%s)\n", updated.Title, updated.URL)
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// defaultPerPage is the number of items list commands fetch per page
+const defaultPerPage = 50
+
+// maxPerPage is the largest page size Canvas accepts
+const maxPerPage = 100
+
+// paginationOptions holds the standard pagination flags shared by list commands
+type paginationOptions struct {
+	page    int
+	perPage int
+	all     bool
+}
+
+// defaultPagination returns the options used when no pagination flags are given
+func defaultPagination() paginationOptions {
+	return paginationOptions{page: 1, perPage: defaultPerPage}
+}
+
+// addPaginationFlags adds --page, --per-page, --all and --auto-page to cmd
+func addPaginationFlags(cmd *cobra.Command, opts *paginationOptions) {
+	cmd.Flags().IntVar(&opts.page, "page", 1, "Page of results to fetch")
+	cmd.Flags().IntVar(&opts.perPage, "per-page", defaultPerPage, fmt.Sprintf("Number of results per page (max %d)", maxPerPage))
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Fetch all pages of results")
+	cmd.Flags().BoolVar(&opts.all, "auto-page", false, "Alias for --all")
+}
+
+// validate checks that the pagination flags are within range
+func (o paginationOptions) validate() error {
+	if o.page < 1 {
+		return fmt.Errorf("--page must be at least 1")
+	}
+	if o.perPage < 1 || o.perPage > maxPerPage {
+		return fmt.Errorf("--per-page must be between 1 and %d", maxPerPage)
+	}
+	return nil
+}
+
+// fetchPages fetches the page selected by opts, or every page when opts.all
+// is set. Every page is fetched with a page of 0, which follows the Link
+// headers Canvas sends, so the last page is known without requesting an
+// empty one after it.
+func fetchPages[T any](opts paginationOptions, fetch func(page, perPage int) ([]T, error)) ([]T, error) {
+	if opts.all {
+		return fetch(0, opts.perPage)
+	}
+	return fetch(opts.page, opts.perPage)
+}
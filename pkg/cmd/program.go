@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// commandContext is cancelled when the process receives SIGINT or SIGTERM,
+// or when the --timeout limit is reached. It is set from the root command's
+// context before any command runs.
+var commandContext = context.Background()
+
+// newClient creates an API client whose requests are bound to commandContext
+func newClient() *api.Client {
+	return api.NewClient().WithContext(commandContext)
+}
+
+// runProgram runs a bubbletea program bound to commandContext. If the
+// program is stopped by a signal or the timeout, the terminal (including the
+// alternate screen) has already been restored when Run returns, so the
+// process exits straight away.
+func runProgram(model tea.Model, opts ...tea.ProgramOption) (tea.Model, error) {
+	opts = append(opts, tea.WithContext(commandContext))
+	result, err := tea.NewProgram(model, opts...).Run()
+	if errors.Is(err, tea.ErrProgramKilled) && commandContext.Err() != nil {
+		if errors.Is(commandContext.Err(), context.DeadlineExceeded) {
+			fmt.Fprintln(os.Stderr, "Error: command timed out")
+			os.Exit(1)
+		}
+		os.Exit(130)
+	}
+	return result, err
+}
@@ -0,0 +1,462 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+// NewQuizzesCmd creates a new command for managing quizzes
+func NewQuizzesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quizzes",
+		Aliases: []string{"quiz"},
+		Short:   "Manage Canvas quizzes",
+		Long:    `List, view, and edit quizzes in Canvas courses.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newQuizzesListCmd(),
+		newQuizzesViewCmd(),
+		newQuizzesEditCmd(),
+	)
+
+	return cmd
+}
+
+func newQuizzesListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [course-id]",
+		Short: "List quizzes in a course",
+		Long:  `List all quizzes in a Canvas course. Select a quiz to view its details.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runQuizzesList(args[0])
+		},
+	}
+}
+
+func newQuizzesViewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "view [course-id] [quiz-id]",
+		Short: "View a quiz",
+		Long:  `View the details and settings of a Canvas quiz.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runQuizzesView(args[0], args[1])
+		},
+	}
+}
+
+func newQuizzesEditCmd() *cobra.Command {
+	var publish bool
+	var unpublish bool
+
+	cmd := &cobra.Command{
+		Use:   "edit [course-id] [quiz-id]",
+		Short: "Edit quiz settings",
+		Long: `Edit the settings of an existing quiz with an interactive form.
+
+Use --publish or --unpublish to only change the published state without the form.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if publish && unpublish {
+				fmt.Fprintln(os.Stderr, "Error: --publish and --unpublish cannot be used together")
+				return
+			}
+			runQuizzesEdit(args[0], args[1], publish, unpublish)
+		},
+	}
+
+	cmd.Flags().BoolVar(&publish, "publish", false, "Publish the quiz without opening the form")
+	cmd.Flags().BoolVar(&unpublish, "unpublish", false, "Unpublish the quiz without opening the form")
+
+	return cmd
+}
+
+// QuizForm represents the data collected from the quiz form
+type QuizForm struct {
+	Title           string
+	Description     string
+	QuizType        string
+	TimeLimit       string
+	AllowedAttempts string
+	ShuffleAnswers  bool
+	Published       bool
+}
+
+// runQuizzesEdit runs the edit quiz command
+func runQuizzesEdit(courseID, quizID string, publish, unpublish bool) {
+	client := newClient()
+	quiz, err := client.GetQuiz(courseID, quizID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching quiz: %v\n", err)
+		return
+	}
+
+	original := *quiz
+
+	if publish || unpublish {
+		quiz.Published = publish
+	} else {
+		if err := runQuizForm(quiz); err != nil {
+			fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+			return
+		}
+	}
+
+	changes := quizChanges(original, *quiz)
+	if len(changes) == 0 {
+		fmt.Println("No changes to save.")
+		return
+	}
+
+	fmt.Println("Changes:")
+	for _, change := range changes {
+		fmt.Println("  " + change)
+	}
+
+	// Only ask for confirmation after the full form
+	if !publish && !unpublish {
+		confirmed := true
+		if err := huh.NewConfirm().
+			Title("Save these changes?").
+			Value(&confirmed).
+			WithTheme(huh.ThemeBase16()).
+			Run(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+			return
+		}
+		if !confirmed {
+			fmt.Println("Changes discarded.")
+			return
+		}
+	}
+
+	// The shortcut sends only the published state so no other setting is overwritten
+	if publish || unpublish {
+		if err := client.SetQuizPublished(courseID, quizID, publish); err != nil {
+			fmt.Fprintf(os.Stderr, "Error updating quiz: %v\n", err)
+			return
+		}
+
+		fmt.Println("\n✅ Quiz updated successfully!")
+		fmt.Printf("ID: %d\n", quiz.ID)
+		fmt.Printf("Title: %s\n", quiz.Title)
+		fmt.Printf("Published: %s\n", yesNo(publish))
+		return
+	}
+
+	updated, err := client.UpdateQuiz(courseID, quizID, quiz)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating quiz: %v\n", err)
+		return
+	}
+
+	fmt.Println("\n✅ Quiz updated successfully!")
+	fmt.Printf("ID: %d\n", updated.ID)
+	fmt.Printf("Title: %s\n", updated.Title)
+	fmt.Printf("Published: %s\n", yesNo(updated.Published))
+}
+
+// runQuizForm shows the quiz form pre-populated with quiz and applies the result to it
+func runQuizForm(quiz *api.Quiz) error {
+	quizTypes := []string{
+		"assignment",
+		"practice_quiz",
+		"graded_survey",
+		"survey",
+	}
+
+	form := QuizForm{
+		Title:          quiz.Title,
+		Description:    quiz.Description,
+		QuizType:       quiz.QuizType,
+		ShuffleAnswers: quiz.ShuffleAnswers,
+		Published:      quiz.Published,
+	}
+	if quiz.TimeLimit > 0 {
+		form.TimeLimit = strconv.Itoa(quiz.TimeLimit)
+	}
+	if quiz.AllowedAttempts != 0 {
+		form.AllowedAttempts = strconv.Itoa(quiz.AllowedAttempts)
+	}
+
+	formUI := huh.NewForm(
+		huh.NewGroup(
+			huh.NewNote().
+				Title("Edit Quiz").
+				Description("Update the settings for this quiz"),
+
+			huh.NewInput().
+				Title("Title").
+				Prompt("> ").
+				Validate(func(s string) error {
+					if s == "" {
+						return fmt.Errorf("title is required")
+					}
+					return nil
+				}).
+				Value(&form.Title),
+
+			huh.NewText().
+				Title("Description").
+				Editor("vi").
+				CharLimit(0).
+				Value(&form.Description),
+
+			huh.NewSelect[string]().
+				Title("Quiz Type").
+				Options(huh.NewOptions(quizTypes...)...).
+				Value(&form.QuizType),
+
+			huh.NewInput().
+				Title("Time Limit (minutes)").
+				Prompt("> ").
+				Placeholder("Leave empty for no time limit").
+				Validate(func(s string) error {
+					if s == "" {
+						return nil
+					}
+					val, err := strconv.Atoi(s)
+					if err != nil || val < 0 {
+						return fmt.Errorf("time limit must be a positive whole number")
+					}
+					return nil
+				}).
+				Value(&form.TimeLimit),
+
+			huh.NewInput().
+				Title("Allowed Attempts").
+				Prompt("> ").
+				Placeholder("-1 for unlimited").
+				Validate(func(s string) error {
+					if s == "" {
+						return nil
+					}
+					if _, err := strconv.Atoi(s); err != nil {
+						return fmt.Errorf("attempts must be a whole number")
+					}
+					return nil
+				}).
+				Value(&form.AllowedAttempts),
+
+			huh.NewConfirm().
+				Title("Shuffle Answers").
+				Value(&form.ShuffleAnswers),
+
+			huh.NewConfirm().
+				Title("Published").
+				Description("Make the quiz visible to students").
+				Value(&form.Published),
+		),
+	).WithTheme(huh.ThemeBase16())
+
+	if err := formUI.Run(); err != nil {
+		return err
+	}
+
+	quiz.Title = form.Title
+	quiz.Description = form.Description
+	quiz.QuizType = form.QuizType
+	quiz.ShuffleAnswers = form.ShuffleAnswers
+	quiz.Published = form.Published
+	quiz.TimeLimit, _ = strconv.Atoi(form.TimeLimit)
+	quiz.AllowedAttempts, _ = strconv.Atoi(form.AllowedAttempts)
+
+	return nil
+}
+
+// quizChanges describes the settings that differ between two versions of a quiz
+func quizChanges(before, after api.Quiz) []string {
+	var changes []string
+
+	if before.Title != after.Title {
+		changes = append(changes, fmt.Sprintf("Title: %q → %q", before.Title, after.Title))
+	}
+	if before.Description != after.Description {
+		changes = append(changes, "Description: updated")
+	}
+	if before.QuizType != after.QuizType {
+		changes = append(changes, fmt.Sprintf("Quiz Type: %s → %s", before.QuizType, after.QuizType))
+	}
+	if before.TimeLimit != after.TimeLimit {
+		changes = append(changes, fmt.Sprintf("Time Limit: %d → %d minutes", before.TimeLimit, after.TimeLimit))
+	}
+	if before.AllowedAttempts != after.AllowedAttempts {
+		changes = append(changes, fmt.Sprintf("Allowed Attempts: %d → %d", before.AllowedAttempts, after.AllowedAttempts))
+	}
+	if before.ShuffleAnswers != after.ShuffleAnswers {
+		changes = append(changes, fmt.Sprintf("Shuffle Answers: %s → %s", yesNo(before.ShuffleAnswers), yesNo(after.ShuffleAnswers)))
+	}
+	if before.Published != after.Published {
+		changes = append(changes, fmt.Sprintf("Published: %s → %s", yesNo(before.Published), yesNo(after.Published)))
+	}
+
+	return changes
+}
+
+// formatTimeLimit formats a quiz time limit in minutes
+func formatTimeLimit(minutes int) string {
+	if minutes == 0 {
+		return "None"
+	}
+	return fmt.Sprintf("%d min", minutes)
+}
+
+func runQuizzesList(courseID string) {
+	client := newClient()
+	quizzes, err := client.GetQuizzes(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching quizzes: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, quiz := range quizzes {
+		rows = append(rows, table.Row{
+			strconv.Itoa(quiz.ID),
+			quiz.Title,
+			quiz.QuizType,
+			strconv.FormatFloat(quiz.PointsPossible, 'f', -1, 64),
+			formatTimeLimit(quiz.TimeLimit),
+			yesNo(quiz.Published),
+		})
+	}
+
+	// Create a table for quizzes
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Title", Width: 35},
+		{Title: "Type", Width: 16},
+		{Title: "Points", Width: 8},
+		{Title: "Time Limit", Width: 10},
+		{Title: "Published", Width: 10},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Quizzes for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • enter: View Quiz • q: Quit"
+
+	// Set up the selection callback to view quiz details
+	m.OnSelect = func(row table.Row) {
+		// Clear screen
+		fmt.Print("\033[H\033[2J")
+
+		runQuizzesView(courseID, row[0])
+
+		// After returning from detail view, restart list view
+		runQuizzesList(courseID)
+	}
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runQuizzesView(courseID, quizID string) {
+	client := newClient()
+	quiz, err := client.GetQuiz(courseID, quizID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching quiz: %v\n", err)
+		return
+	}
+
+	render := func(width int) string {
+		return formatQuiz(quiz, width)
+	}
+
+	// Print plain text when the output is piped
+	if !stdoutIsTerminal() {
+		fmt.Println(render(80))
+		return
+	}
+
+	model := ui.NewViewportModel("Quiz Details", render)
+	if _, err := runProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running quiz view: %v\n", err)
+	}
+}
+
+// formatQuiz formats every property of a quiz
+func formatQuiz(quiz *api.Quiz, width int) string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("205")).
+		Bold(true)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("39")).
+		Bold(true).
+		Width(18)
+
+	sectionStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("99")).
+		Bold(true).
+		MarginTop(1).
+		MarginBottom(1)
+
+	formatDate := func(t time.Time) string {
+		if t.IsZero() {
+			return "Not set"
+		}
+		return t.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
+	}
+
+	attempts := "Unlimited"
+	if quiz.AllowedAttempts > 0 {
+		attempts = strconv.Itoa(quiz.AllowedAttempts)
+	}
+
+	var content strings.Builder
+	content.WriteString(titleStyle.Render(quiz.Title) + "\n")
+
+	content.WriteString(sectionStyle.Render("Settings") + "\n")
+	content.WriteString(labelStyle.Render("ID:") + strconv.Itoa(quiz.ID) + "\n")
+	content.WriteString(labelStyle.Render("Type:") + quiz.QuizType + "\n")
+	content.WriteString(labelStyle.Render("Points:") + strconv.FormatFloat(quiz.PointsPossible, 'f', -1, 64) + "\n")
+	content.WriteString(labelStyle.Render("Questions:") + strconv.Itoa(quiz.QuestionCount) + "\n")
+	content.WriteString(labelStyle.Render("Time Limit:") + formatTimeLimit(quiz.TimeLimit) + "\n")
+	content.WriteString(labelStyle.Render("Allowed Attempts:") + attempts + "\n")
+	content.WriteString(labelStyle.Render("Shuffle Answers:") + yesNo(quiz.ShuffleAnswers) + "\n")
+	content.WriteString(labelStyle.Render("Published:") + yesNo(quiz.Published) + "\n")
+
+	content.WriteString(sectionStyle.Render("Dates") + "\n")
+	content.WriteString(labelStyle.Render("Due Date:") + formatDate(quiz.DueAt) + "\n")
+	content.WriteString(labelStyle.Render("Available From:") + formatDate(quiz.UnlockAt) + "\n")
+	content.WriteString(labelStyle.Render("Available Until:") + formatDate(quiz.LockAt) + "\n")
+
+	if quiz.Description != "" {
+		content.WriteString(sectionStyle.Render("Description") + "\n")
+		content.WriteString(lipgloss.NewStyle().Width(width-6).Render(stripHTML(quiz.Description)) + "\n")
+	}
+
+	return content.String()
+}
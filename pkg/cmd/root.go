@@ -1,28 +1,138 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
 	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
 	"github.com/spf13/cobra"
 )
 
+// maxConcurrencyFlag holds the --max-concurrency flag, 0 when not set
+var maxConcurrencyFlag int
+
+// maxConcurrency returns the number of concurrent API requests bulk
+// operations may make, from --max-concurrency or the max_concurrency config key
+func maxConcurrency() int {
+	if maxConcurrencyFlag > 0 {
+		return maxConcurrencyFlag
+	}
+	if n := config.GetConfig().MaxConcurrency; n > 0 {
+		return n
+	}
+	return 5
+}
+
 func NewRootCmd() *cobra.Command {
+	var showStats bool
+	var verbose int
+	var noColor bool
+	var dryRun bool
+	var noCache bool
+	var profile string
+	var configDir string
+	var timeout time.Duration
+	var cancelTimeout context.CancelFunc
+
 	rootCmd := &cobra.Command{
 		Use:   "canvas-cli",
 		Short: "A CLI for interacting with the Canvas LMS API",
 		Long: `Canvas CLI is a command line interface for interacting with the Canvas LMS API.
 It provides commands for managing courses, assignments, grades, and more.
 Built with Charm libraries for a delightful terminal experience.`,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			// Initialize config once the flags, including --config-dir, are parsed
+			config.InitConfig(configDir)
+			api.DebugLevel = verbose
+			api.DryRun = dryRun
+			api.NoCache = noCache
+
+			// Follow https://no-color.org: any non-empty NO_COLOR turns colors off
+			if noColor || os.Getenv("NO_COLOR") != "" {
+				ui.DisableColor()
+			}
+
+			// Cancel API requests and TUI programs when the process is
+			// interrupted or the timeout is reached
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			if timeout > 0 {
+				ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+			}
+			commandContext = ctx
+
+			if profile != "" {
+				if err := config.UseProfile(profile); err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					os.Exit(1)
+				}
+			}
+
+			if _, err := ui.NewOutputWriter(outputFormat, os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			if cancelTimeout != nil {
+				cancelTimeout()
+			}
+			if showStats {
+				printRequestStats(os.Stderr)
+			}
+			if dryRun {
+				fmt.Fprintln(os.Stderr, "DRY RUN: no changes were made in Canvas")
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			// Launch the setup wizard the first time the CLI is run
+			if config.IsFirstRun() {
+				runWizard(cmd)
+				return
+			}
+			cmd.Help()
+		},
 	}
 
-	// Initialize config
-	config.InitConfig()
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "table", "Output format for list commands (table, json, csv, yaml, markdown, html)")
+	rootCmd.PersistentFlags().IntVar(&maxConcurrencyFlag, "max-concurrency", 0, "Maximum concurrent API requests for bulk operations (default from max_concurrency config, 5)")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "Cancel the command after this long, such as 30s or 2m (default no limit)")
+	rootCmd.PersistentFlags().StringVar(&configDir, "config-dir", "", "Directory holding config.yaml (default $CANVAS_CLI_CONFIG, $XDG_CONFIG_HOME/canvas-cli, or ~/.config/canvas-cli)")
+	rootCmd.PersistentFlags().StringVar(&profile, "profile", "", "Use this profile's Canvas credentials for this command")
+	rootCmd.PersistentFlags().BoolVar(&showStats, "show-stats", false, "Print API usage statistics when the command finishes")
+	rootCmd.PersistentFlags().CountVarP(&verbose, "verbose", "v", "Log API requests to stderr; -vv also logs request and response bodies")
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colors and text styling (also set by the NO_COLOR environment variable)")
+	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Print the create, update, and delete requests a command would make instead of sending them")
+	rootCmd.PersistentFlags().BoolVar(&noCache, "no-cache", false, "Send every request instead of reusing responses from earlier in the command")
 
 	// Add commands
 	rootCmd.AddCommand(
 		NewCoursesCmd(),
 		NewAssignmentsCmd(),
+		NewDueDatesCmd(),
+		NewDashboardCmd(),
 		NewUsersCmd(),
+		NewSubmissionsCmd(),
+		NewGradesCmd(),
+		NewRubricsCmd(),
+		NewPagesCmd(),
+		NewModulesCmd(),
+		NewSectionsCmd(),
+		NewGroupsCmd(),
+		NewDiscussionsCmd(),
+		NewAnnouncementsCmd(),
+		NewFilesCmd(),
+		NewQuizzesCmd(),
 		NewConfigCmd(),
+		NewWizardCmd(),
+		NewBookmarksCmd(),
+		NewSearchCmd(),
 	)
 
 	return rootCmd
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	lgtable "github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+)
+
+// NewRubricsCmd creates a new command for managing rubrics
+func NewRubricsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rubrics",
+		Short: "Manage Canvas rubrics",
+		Long:  `List and view rubrics in Canvas courses and attach them to assignments.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newRubricsListCmd(),
+		newRubricsViewCmd(),
+		newRubricsAssociateCmd(),
+	)
+
+	return cmd
+}
+
+func newRubricsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [course-id]",
+		Short: "List rubrics in a course",
+		Long:  `List the rubrics of a Canvas course with their criteria count and points.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runRubricsList(args[0])
+		},
+	}
+}
+
+func newRubricsViewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "view [course-id] [rubric-id]",
+		Short: "View a rubric",
+		Long:  `View each criterion of a rubric with its ratings and point values.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runRubricsView(args[0], args[1])
+		},
+	}
+}
+
+func newRubricsAssociateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "associate [course-id] [assignment-id] [rubric-id]",
+		Short: "Attach a rubric to an assignment",
+		Long:  `Attach a rubric to an assignment and use it for grading the assignment.`,
+		Args:  cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			courseID := args[0]
+			assignmentID := args[1]
+			rubricID := args[2]
+
+			client := newClient()
+			if err := client.AssociateRubric(courseID, assignmentID, rubricID); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return
+			}
+
+			fmt.Printf("Successfully attached rubric %s to assignment %s\n", rubricID, assignmentID)
+		},
+	}
+}
+
+func runRubricsList(courseID string) {
+	client := newClient()
+	rubrics, err := client.GetRubrics(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching rubrics: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, rubric := range rubrics {
+		rows = append(rows, table.Row{
+			strconv.Itoa(rubric.ID),
+			rubric.Title,
+			strconv.Itoa(len(rubric.Criteria)),
+			strconv.FormatFloat(rubric.PointsPossible, 'f', -1, 64),
+		})
+	}
+
+	// Create a table for rubrics
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Title", Width: 40},
+		{Title: "Criteria", Width: 9},
+		{Title: "Points", Width: 8},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Rubrics for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func runRubricsView(courseID, rubricID string) {
+	client := newClient()
+	rubric, err := client.GetRubric(courseID, rubricID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching rubric: %v\n", err)
+		return
+	}
+
+	render := func(width int) string {
+		return formatRubric(rubric, width)
+	}
+
+	// Print plain text when the output is piped
+	if !stdoutIsTerminal() {
+		fmt.Println(render(80))
+		return
+	}
+
+	model := ui.NewViewportModel("Rubric", render)
+	if _, err := runProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running rubric view: %v\n", err)
+	}
+}
+
+// formatRubric formats a rubric with a table of ratings for each criterion
+func formatRubric(rubric *api.Rubric, width int) string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("205")).
+		Bold(true)
+
+	criterionStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("99")).
+		Bold(true).
+		MarginTop(1)
+
+	descriptionStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("245")).
+		Width(width - 6)
+
+	var content strings.Builder
+	content.WriteString(titleStyle.Render(rubric.Title) + "\n")
+	content.WriteString(fmt.Sprintf("%d criteria • %s points\n",
+		len(rubric.Criteria), strconv.FormatFloat(rubric.PointsPossible, 'f', -1, 64)))
+
+	for _, criterion := range rubric.Criteria {
+		content.WriteString(criterionStyle.Render(fmt.Sprintf("%s (%s pts)",
+			criterion.Description, strconv.FormatFloat(criterion.Points, 'f', -1, 64))) + "\n")
+		if criterion.LongDescription != "" {
+			content.WriteString(descriptionStyle.Render(criterion.LongDescription) + "\n")
+		}
+
+		ratings := lgtable.New().
+			Border(lipgloss.NormalBorder()).
+			BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("240"))).
+			Headers("Points", "Rating", "Description").
+			StyleFunc(func(row, col int) lipgloss.Style {
+				return lipgloss.NewStyle().Padding(0, 1)
+			}).
+			Width(width - 6)
+		for _, rating := range criterion.Ratings {
+			ratings.Row(
+				strconv.FormatFloat(rating.Points, 'f', -1, 64),
+				rating.Description,
+				rating.LongDescription,
+			)
+		}
+		content.WriteString(ratings.Render() + "\n")
+	}
+
+	return content.String()
+}
@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+)
+
+// NewSearchCmd creates a new command for searching across Canvas resources
+func NewSearchCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "search [query]",
+		Short: "Search courses, assignments, and users",
+		Long: `Search your courses, and the assignments and users of your active courses,
+for names containing the query (ignoring case).
+
+Select a result to open it.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runSearch(args[0])
+		},
+	}
+}
+
+// searchResult is a course, assignment, or user matching a search
+type searchResult struct {
+	kind     string // "Course", "Assignment", or "User"
+	id       string
+	name     string
+	courseID string
+	course   string
+}
+
+// courseSearchData holds the assignments and users fetched for one course
+type courseSearchData struct {
+	assignments []api.Assignment
+	users       []api.User
+	err         error
+}
+
+func runSearch(query string) {
+	client := newClient()
+	courses, err := client.GetCourses()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching courses: %v\n", err)
+		return
+	}
+
+	now := time.Now()
+	var active []api.Course
+	for _, course := range courses {
+		if isActiveCourse(course, now) {
+			active = append(active, course)
+		}
+	}
+
+	// Fetch the assignments and users of every active course concurrently
+	data := make([]courseSearchData, len(active))
+	sem := make(chan struct{}, maxConcurrency())
+	var wg sync.WaitGroup
+	for i, course := range active {
+		wg.Add(1)
+		go func(i int, course api.Course) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			courseID := strconv.Itoa(course.ID)
+			assignments, err := client.GetAssignments(courseID, 0, 0)
+			if err != nil {
+				data[i].err = err
+				return
+			}
+			users, err := fetchAllUsers(client, courseID)
+			if err != nil {
+				data[i].err = err
+				return
+			}
+			data[i] = courseSearchData{assignments: assignments, users: users}
+		}(i, course)
+	}
+	wg.Wait()
+
+	lowerQuery := strings.ToLower(query)
+	matches := func(values ...string) bool {
+		for _, value := range values {
+			if strings.Contains(strings.ToLower(value), lowerQuery) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var courseResults, assignmentResults, userResults []searchResult
+	for _, course := range courses {
+		if matches(course.Name, course.CourseCode) {
+			courseResults = append(courseResults, searchResult{
+				kind: "Course",
+				id:   strconv.Itoa(course.ID),
+				name: course.Name,
+			})
+		}
+	}
+
+	// Users are often enrolled in several courses, so only list them once
+	seenUsers := make(map[int]bool)
+	for i, course := range active {
+		if data[i].err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: could not search %s: %v\n", course.Name, data[i].err)
+			continue
+		}
+
+		courseID := strconv.Itoa(course.ID)
+		for _, assignment := range data[i].assignments {
+			if matches(assignment.Name) {
+				assignmentResults = append(assignmentResults, searchResult{
+					kind:     "Assignment",
+					id:       strconv.Itoa(assignment.ID),
+					name:     assignment.Name,
+					courseID: courseID,
+					course:   course.Name,
+				})
+			}
+		}
+		for _, user := range data[i].users {
+			if !seenUsers[user.ID] && matches(user.Name, user.Email) {
+				seenUsers[user.ID] = true
+				userResults = append(userResults, searchResult{
+					kind:   "User",
+					id:     strconv.Itoa(user.ID),
+					name:   user.Name,
+					course: course.Name,
+				})
+			}
+		}
+	}
+
+	results := append(append(courseResults, assignmentResults...), userResults...)
+
+	rows := []table.Row{}
+	for _, result := range results {
+		rows = append(rows, table.Row{result.kind, result.id, result.name, result.course})
+	}
+
+	columns := []table.Column{
+		{Title: "Type", Width: 12},
+		{Title: "ID", Width: 10},
+		{Title: "Name", Width: 40},
+		{Title: "Course", Width: 30},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	if len(results) == 0 {
+		fmt.Printf("No results for %q.\n", query)
+		return
+	}
+
+	options := make([]huh.Option[int], len(results))
+	for i, result := range results {
+		label := fmt.Sprintf("%-11s %s", result.kind, result.name)
+		if result.course != "" {
+			label += fmt.Sprintf(" (%s)", result.course)
+		}
+		options[i] = huh.NewOption(label, i)
+	}
+
+	var selected int
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[int]().
+				Title(fmt.Sprintf("Results for %q", query)).
+				Description(fmt.Sprintf("%d courses • %d assignments • %d users",
+					len(courseResults), len(assignmentResults), len(userResults))).
+				Options(options...).
+				Value(&selected),
+		),
+	).WithTheme(huh.ThemeBase16())
+
+	if err := form.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	// Open the selected result with its view command
+	result := results[selected]
+	switch result.kind {
+	case "Course":
+		runCoursesView(result.id)
+	case "Assignment":
+		runAssignmentsView(nil, []string{result.courseID, result.id})
+	case "User":
+		runUsersView(result.id, false)
+	}
+}
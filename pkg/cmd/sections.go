@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/spf13/cobra"
+)
+
+// NewSectionsCmd creates a new command for managing course sections
+func NewSectionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sections",
+		Short: "Manage Canvas course sections",
+		Long:  `List the sections of Canvas courses.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newSectionsListCmd(),
+	)
+
+	return cmd
+}
+
+func newSectionsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [course-id]",
+		Short: "List sections in a course",
+		Long:  `List all sections of a Canvas course with their student counts.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runSectionsList(args[0])
+		},
+	}
+}
+
+func runSectionsList(courseID string) {
+	client := newClient()
+	sections, err := client.GetSections(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching sections: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, section := range sections {
+		startDate := ""
+		if !section.StartAt.IsZero() {
+			startDate = section.StartAt.In(config.Location()).Format("Jan 2, 2006")
+		}
+		endDate := ""
+		if !section.EndAt.IsZero() {
+			endDate = section.EndAt.In(config.Location()).Format("Jan 2, 2006")
+		}
+
+		rows = append(rows, table.Row{
+			strconv.Itoa(section.ID),
+			section.Name,
+			section.SISSourceID,
+			strconv.Itoa(section.StudentCount),
+			startDate,
+			endDate,
+		})
+	}
+
+	// Create a table for sections
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Name", Width: 30},
+		{Title: "SIS ID", Width: 15},
+		{Title: "Students", Width: 9},
+		{Title: "Start Date", Width: 14},
+		{Title: "End Date", Width: 14},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Sections for Course %s", courseID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// resolveSectionID returns the ID of the course section whose ID or name
+// (case-insensitively) matches section
+func resolveSectionID(client *api.Client, courseID, section string) (string, error) {
+	sections, err := client.GetSections(courseID)
+	if err != nil {
+		return "", fmt.Errorf("error fetching sections: %w", err)
+	}
+
+	for _, s := range sections {
+		if strconv.Itoa(s.ID) == section {
+			return section, nil
+		}
+	}
+	for _, s := range sections {
+		if strings.EqualFold(s.Name, section) {
+			return strconv.Itoa(s.ID), nil
+		}
+	}
+
+	return "", fmt.Errorf("no section %q found in course %s", section, courseID)
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+)
+
+// printRequestStats writes a summary of the API requests made this session
+func printRequestStats(w io.Writer) {
+	stats := api.DefaultRequestLog.Stats()
+
+	fmt.Fprintln(w, "\nAPI Usage:")
+	fmt.Fprintln(w, "----------")
+	fmt.Fprintf(w, "Total Requests: %d\n", stats.Total)
+	if stats.Total == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "Data Transferred: %s\n", formatBytes(stats.Bytes))
+	fmt.Fprintf(w, "Slowest Request: %s %s (%s)\n", stats.Slowest.Method, stats.Slowest.Path, stats.Slowest.Duration.Round(time.Millisecond))
+	fmt.Fprintf(w, "Most Called Endpoint: %s (%d requests)\n", stats.MostCalled, stats.MostCalledCount)
+	fmt.Fprintf(w, "Error Rate: %.1f%% (%d of %d)\n", stats.ErrorRate()*100, stats.Errors, stats.Total)
+}
+
+// formatBytes formats a byte count using binary units
+func formatBytes(n int) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	value := float64(n)
+	suffixes := []string{"KB", "MB", "GB"}
+	for i, suffix := range suffixes {
+		value /= unit
+		if value < unit || i == len(suffixes)-1 {
+			return fmt.Sprintf("%.1f %s", value, suffix)
+		}
+	}
+	return fmt.Sprintf("%d B", n)
+}
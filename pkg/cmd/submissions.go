@@ -0,0 +1,966 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	lgtable "github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+)
+
+// NewSubmissionsCmd creates a new command for managing submissions
+func NewSubmissionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submissions",
+		Short: "Manage Canvas submissions",
+		Long:  `View and grade assignment submissions in Canvas.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	// Add subcommands
+	cmd.AddCommand(
+		newSubmissionsListCmd(),
+		newSubmissionsViewCmd(),
+		newSubmissionsGradeCmd(),
+		newSubmissionsCommentsCmd(),
+		newSubmissionsImportCmd(),
+	)
+
+	return cmd
+}
+
+func newSubmissionsListCmd() *cobra.Command {
+	var colorLate bool
+	var colorMissing bool
+	var pagination paginationOptions
+	var watch time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "list [course-id] [assignment-id]",
+		Short: "List submissions for an assignment",
+		Long: `List all submissions for a specific assignment in Canvas.
+
+Results are fetched one page at a time; use --page and --per-page to choose
+the page, or --all to fetch every page.
+
+With --watch, the table is fetched again every 30 seconds, or at the interval
+given with --watch=10s, showing when it was last updated.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := pagination.validate(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return
+			}
+			runSubmissionsList(args[0], args[1], colorLate, colorMissing, pagination, watch)
+		},
+	}
+
+	cmd.Flags().BoolVar(&colorLate, "color-late", false, "Highlight late submissions in red")
+	cmd.Flags().BoolVar(&colorMissing, "color-missing", false, "Highlight missing submissions in yellow")
+	addPaginationFlags(cmd, &pagination)
+	addWatchFlag(cmd, &watch)
+
+	return cmd
+}
+
+func newSubmissionsViewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "view [course-id] [assignment-id] [user-id]",
+		Short: "View a submission",
+		Long:  `View a user's submission for an assignment, including its body and comments.`,
+		Args:  cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			runSubmissionsView(args[0], args[1], args[2])
+		},
+	}
+}
+
+func newSubmissionsGradeCmd() *cobra.Command {
+	var comment string
+	var useRubric bool
+
+	cmd := &cobra.Command{
+		Use:   "grade [course-id] [assignment-id] [user-id] [grade]",
+		Short: "Grade a submission",
+		Long: `Grade a user's submission for an assignment.
+
+With --rubric, each criterion of the assignment's rubric is presented in turn
+and the grade is computed from the selected ratings, so no grade argument is needed.`,
+		Args: cobra.RangeArgs(3, 4),
+		Run: func(cmd *cobra.Command, args []string) {
+			courseID := args[0]
+			assignmentID := args[1]
+			userID := args[2]
+
+			if useRubric {
+				runSubmissionsRubricGrade(courseID, assignmentID, userID)
+				return
+			}
+
+			if len(args) < 4 {
+				fmt.Fprintln(os.Stderr, "Error: a grade is required unless --rubric is set")
+				return
+			}
+
+			client := newClient()
+			submission, err := client.GradeSubmission(courseID, assignmentID, userID, args[3], comment)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error grading submission: %v\n", err)
+				return
+			}
+
+			fmt.Printf("Successfully graded user %s: %s (score %.1f)\n", userID, submission.Grade, submission.Score)
+		},
+	}
+
+	cmd.Flags().StringVarP(&comment, "comment", "c", "", "Add a comment to the submission")
+	cmd.Flags().BoolVarP(&useRubric, "rubric", "r", false, "Grade criterion by criterion using the assignment's rubric")
+
+	return cmd
+}
+
+func newSubmissionsCommentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "comments",
+		Short: "Read and add submission comments",
+		Long:  `List the comments on a user's submission, or add a new one.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(
+		newSubmissionsCommentsListCmd(),
+		newSubmissionsCommentsAddCmd(),
+	)
+
+	return cmd
+}
+
+func newSubmissionsCommentsListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [course-id] [assignment-id] [user-id]",
+		Short: "List the comments on a submission",
+		Long:  `List the comments on a user's submission, oldest first, with their authors and times.`,
+		Args:  cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			runSubmissionsCommentsList(args[0], args[1], args[2])
+		},
+	}
+}
+
+func newSubmissionsCommentsAddCmd() *cobra.Command {
+	var message string
+
+	cmd := &cobra.Command{
+		Use:   "add [course-id] [assignment-id] [user-id]",
+		Short: "Add a comment to a submission",
+		Long: `Add a comment to a user's submission without changing its grade.
+
+The comment is written in an editor unless it is given with --message.`,
+		Args: cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			runSubmissionsCommentsAdd(args[0], args[1], args[2], message)
+		},
+	}
+
+	cmd.Flags().StringVarP(&message, "message", "m", "", "Comment text, instead of writing it in an editor")
+
+	return cmd
+}
+
+func newSubmissionsImportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [course-id] [assignment-id] [csv-file]",
+		Short: "Grade submissions from a CSV file",
+		Long: `Grade the submissions of an assignment from a CSV file.
+
+The CSV needs a header row with the columns user_id and score, and may also
+have a comment for each student. Every row is checked before any grade is
+submitted, and scores may not be more than the assignment's points possible.
+Students already graded with the same score are skipped unless the row has a
+comment. With --dry-run, the CSV is only checked.`,
+		Args: cobra.ExactArgs(3),
+		Run: func(cmd *cobra.Command, args []string) {
+			runSubmissionsImport(args[0], args[1], args[2])
+		},
+	}
+}
+
+func runSubmissionsCommentsList(courseID, assignmentID, userID string) {
+	client := newClient()
+	comments, err := client.GetSubmissionComments(courseID, assignmentID, userID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching comments: %v\n", err)
+		return
+	}
+
+	rows := []table.Row{}
+	for _, comment := range comments {
+		rows = append(rows, table.Row{
+			comment.AuthorName,
+			comment.CreatedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM"),
+			comment.Comment,
+		})
+	}
+
+	columns := []table.Column{
+		{Title: "Author", Width: 25},
+		{Title: "Date", Width: 22},
+		{Title: "Comment", Width: 60},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	if len(comments) == 0 {
+		fmt.Println("No comments on this submission.")
+		return
+	}
+
+	authorStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("39"))
+	dateStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	textStyle := lipgloss.NewStyle().Width(80).PaddingLeft(2)
+
+	for _, comment := range comments {
+		fmt.Println(authorStyle.Render(comment.AuthorName) + " " + dateStyle.Render(comment.CreatedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")))
+		fmt.Println(textStyle.Render(comment.Comment))
+		fmt.Println()
+	}
+}
+
+func runSubmissionsCommentsAdd(courseID, assignmentID, userID, message string) {
+	if message == "" {
+		form := huh.NewForm(
+			huh.NewGroup(
+				huh.NewText().
+					Title("Comment").
+					Placeholder("Write your comment").
+					Editor("vi").
+					CharLimit(10000).
+					Validate(func(s string) error {
+						if strings.TrimSpace(s) == "" {
+							return fmt.Errorf("comment cannot be empty")
+						}
+						return nil
+					}).
+					Value(&message),
+			),
+		).WithTheme(huh.ThemeBase16())
+
+		if err := form.Run(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+			return
+		}
+	}
+
+	client := newClient()
+	if err := client.AddSubmissionComment(courseID, assignmentID, userID, message); err != nil {
+		fmt.Fprintf(os.Stderr, "Error adding comment: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Successfully added a comment to the submission of user %s\n", userID)
+}
+
+// rubricSelection holds the form values collected for one rubric criterion
+type rubricSelection struct {
+	ratingID string
+	comments string
+}
+
+// runSubmissionsRubricGrade grades a submission using the assignment's rubric
+func runSubmissionsRubricGrade(courseID, assignmentID, userID string) {
+	client := newClient()
+	assignment, err := client.GetAssignment(courseID, assignmentID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching assignment: %v\n", err)
+		return
+	}
+
+	if len(assignment.Rubric) == 0 {
+		fmt.Fprintf(os.Stderr, "Assignment %s has no rubric\n", assignmentID)
+		return
+	}
+
+	// Build one form group per criterion
+	selections := make([]rubricSelection, len(assignment.Rubric))
+	groups := make([]*huh.Group, 0, len(assignment.Rubric))
+	for i, criterion := range assignment.Rubric {
+		options := make([]huh.Option[string], 0, len(criterion.Ratings))
+		for _, rating := range criterion.Ratings {
+			label := fmt.Sprintf("%s (%.1f pts)", rating.Description, rating.Points)
+			options = append(options, huh.NewOption(label, rating.ID))
+		}
+
+		groups = append(groups, huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(fmt.Sprintf("%s (%.1f pts)", criterion.Description, criterion.Points)).
+				Description(criterion.LongDescription).
+				Options(options...).
+				Value(&selections[i].ratingID),
+
+			huh.NewText().
+				Title("Comments").
+				Placeholder("Optional comments for this criterion").
+				CharLimit(1000).
+				Value(&selections[i].comments),
+		))
+	}
+
+	if err := huh.NewForm(groups...).WithTheme(huh.ThemeBase16()).Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	// Map the selected ratings back to points
+	assessment := make(map[string]api.RubricAssessment, len(assignment.Rubric))
+	var total float64
+	for i, criterion := range assignment.Rubric {
+		entry := api.RubricAssessment{
+			RatingID: selections[i].ratingID,
+			Comments: selections[i].comments,
+		}
+		for _, rating := range criterion.Ratings {
+			if rating.ID == selections[i].ratingID {
+				entry.Points = rating.Points
+				break
+			}
+		}
+		total += entry.Points
+		assessment[criterion.ID] = entry
+	}
+
+	submission, err := client.GradeSubmissionWithRubric(courseID, assignmentID, userID, assessment)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error submitting rubric assessment: %v\n", err)
+		return
+	}
+
+	fmt.Println("\n✅ Rubric assessment submitted successfully!")
+	fmt.Printf("User: %s\n", userID)
+	fmt.Printf("Score: %.1f / %.1f\n", total, assignment.PointsPossible)
+	if submission.Grade != "" {
+		fmt.Printf("Grade: %s\n", submission.Grade)
+	}
+}
+
+// fetchSubmissionRows fetches the submissions for an assignment and builds their table rows
+func fetchSubmissionRows(client *api.Client, courseID, assignmentID string, pagination paginationOptions) ([]table.Row, error) {
+	submissions, err := fetchPages(pagination, func(page, perPage int) ([]api.Submission, error) {
+		return client.GetSubmissions(courseID, assignmentID, page, perPage)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []table.Row{}
+	for _, submission := range submissions {
+		submittedAt := ""
+		if !submission.SubmittedAt.IsZero() {
+			submittedAt = submission.SubmittedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
+		}
+
+		rows = append(rows, table.Row{
+			strconv.Itoa(submission.UserID),
+			submission.User.Name,
+			submittedAt,
+			submission.Grade,
+			yesNo(submission.Late),
+			yesNo(submission.Missing),
+		})
+	}
+
+	return rows, nil
+}
+
+func runSubmissionsList(courseID, assignmentID string, colorLate, colorMissing bool, pagination paginationOptions, watch time.Duration) {
+	client := newClient()
+	rows, err := fetchSubmissionRows(client, courseID, assignmentID, pagination)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching submissions: %v\n", err)
+		return
+	}
+
+	// Create a table for submissions
+	columns := []table.Column{
+		{Title: "User ID", Width: 10},
+		{Title: "Student", Width: 25},
+		{Title: "Submitted At", Width: 20},
+		{Title: "Grade", Width: 10},
+		{Title: "Late", Width: 6},
+		{Title: "Missing", Width: 8},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Submissions for Assignment %s", assignmentID)
+	m.Help = "↑/↓: Navigate • q: Quit"
+
+	fetchPage := func(page int) ([]table.Row, error) {
+		pageOpts := pagination
+		pageOpts.page = page
+		return fetchSubmissionRows(client, courseID, assignmentID, pageOpts)
+	}
+	if !pagination.all {
+		m.EnablePaging(pagination.page, fetchPage)
+	}
+	watchTable(m, client, watch, fetchPage)
+
+	if colorLate || colorMissing {
+		lateStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+		missingStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("226"))
+
+		m.ColorRowFunc = func(row table.Row) lipgloss.Style {
+			if colorLate && row[4] == "Yes" {
+				return lateStyle
+			}
+			if colorMissing && row[5] == "Yes" {
+				return missingStyle
+			}
+			return lipgloss.NewStyle()
+		}
+	}
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// SubmissionDetailModel represents a model for viewing a submission
+type SubmissionDetailModel struct {
+	submission   *api.Submission
+	err          error
+	viewport     viewport.Model
+	ready        bool
+	width        int
+	height       int
+	courseID     string
+	assignmentID string
+	userID       string
+}
+
+// NewSubmissionDetailModel initializes the submission detail model
+func NewSubmissionDetailModel(courseID, assignmentID, userID string) SubmissionDetailModel {
+	return SubmissionDetailModel{
+		courseID:     courseID,
+		assignmentID: assignmentID,
+		userID:       userID,
+	}
+}
+
+// Messages for the submission detail model
+type submissionLoadedMsg struct {
+	submission *api.Submission
+}
+
+type submissionErrorMsg struct {
+	err error
+}
+
+// Init fetches the submission
+func (m SubmissionDetailModel) Init() tea.Cmd {
+	return func() tea.Msg {
+		client := newClient()
+		submission, err := client.GetSubmission(m.courseID, m.assignmentID, m.userID)
+		if err != nil {
+			return submissionErrorMsg{err}
+		}
+		return submissionLoadedMsg{submission}
+	}
+}
+
+// Update updates the submission detail model
+func (m SubmissionDetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds []tea.Cmd
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "q", "enter", "ctrl+c":
+			return m, tea.Quit
+		}
+
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+		m.width = msg.Width
+
+		if !m.ready {
+			m.viewport = viewport.New(m.width, m.height-4) // leave room for header/footer
+			m.viewport.Style = lipgloss.NewStyle().
+				BorderStyle(lipgloss.RoundedBorder()).
+				BorderForeground(lipgloss.Color("62")).
+				PaddingRight(2)
+			m.ready = true
+		} else {
+			m.viewport.Width = msg.Width
+			m.viewport.Height = msg.Height - 4
+		}
+
+		if m.submission != nil {
+			m.viewport.SetContent(m.formatSubmissionDetails())
+		}
+
+	case submissionLoadedMsg:
+		m.submission = msg.submission
+		if m.ready {
+			m.viewport.SetContent(m.formatSubmissionDetails())
+		}
+
+	case submissionErrorMsg:
+		m.err = msg.err
+		return m, tea.Quit
+	}
+
+	if m.ready {
+		m.viewport, cmd = m.viewport.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return m, tea.Batch(cmds...)
+}
+
+// View renders the submission detail model
+func (m SubmissionDetailModel) View() string {
+	if !m.ready || (m.submission == nil && m.err == nil) {
+		return "Loading..."
+	}
+
+	if m.submission == nil {
+		return ""
+	}
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("205")).
+		Bold(true).
+		MarginBottom(1).
+		PaddingLeft(2)
+
+	footerStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		PaddingTop(1).
+		PaddingLeft(2)
+
+	return headerStyle.Render("Submission Details") + "\n" +
+		m.viewport.View() + "\n" +
+		footerStyle.Render("↑/↓: Scroll • q/esc: Quit")
+}
+
+// formatSubmissionDetails formats the submission as a styled string
+func (m SubmissionDetailModel) formatSubmissionDetails() string {
+	submission := m.submission
+
+	titleStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("205")).
+		Bold(true).
+		MarginBottom(1).
+		Width(m.width - 4)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("39")).
+		Bold(true).
+		Width(18)
+
+	valueStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("255")).
+		Width(m.width - 24)
+
+	sectionStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("99")).
+		Bold(true).
+		MarginTop(1).
+		MarginBottom(1)
+
+	var content strings.Builder
+
+	name := submission.User.Name
+	if name == "" {
+		name = fmt.Sprintf("User %d", submission.UserID)
+	}
+	content.WriteString(titleStyle.Render(name) + "\n\n")
+
+	content.WriteString(sectionStyle.Render("Submission") + "\n")
+
+	submittedAt := "Not submitted"
+	if !submission.SubmittedAt.IsZero() {
+		submittedAt = submission.SubmittedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
+	}
+	content.WriteString(labelStyle.Render("Submitted At:") + valueStyle.Render(submittedAt) + "\n")
+	content.WriteString(labelStyle.Render("Attempt:") + valueStyle.Render(strconv.Itoa(submission.AttemptNumber)) + "\n")
+	content.WriteString(labelStyle.Render("Type:") + valueStyle.Render(submission.SubmissionType) + "\n")
+	content.WriteString(labelStyle.Render("Status:") + valueStyle.Render(submission.WorkflowState) + "\n")
+	content.WriteString(labelStyle.Render("Late:") + valueStyle.Render(yesNo(submission.Late)) + "\n")
+	content.WriteString(labelStyle.Render("Missing:") + valueStyle.Render(yesNo(submission.Missing)) + "\n")
+	if submission.URL != "" {
+		content.WriteString(labelStyle.Render("URL:") + valueStyle.Render(submission.URL) + "\n")
+	}
+
+	content.WriteString(sectionStyle.Render("Grade") + "\n")
+
+	grade := "Not graded"
+	if submission.Grade != "" {
+		grade = fmt.Sprintf("%s (score %.1f)", submission.Grade, submission.Score)
+	}
+	content.WriteString(labelStyle.Render("Grade:") + valueStyle.Render(grade) + "\n")
+	if !submission.GradedAt.IsZero() {
+		content.WriteString(labelStyle.Render("Graded At:") + valueStyle.Render(submission.GradedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM")) + "\n")
+	}
+
+	// Wrap long text to fit the viewport
+	textStyle := lipgloss.NewStyle().Width(m.width - 6)
+
+	if submission.Body != "" {
+		content.WriteString(sectionStyle.Render("Body") + "\n")
+		content.WriteString(textStyle.Render(submission.Body) + "\n")
+	}
+
+	content.WriteString(sectionStyle.Render(fmt.Sprintf("Comments (%d)", len(submission.Comments))) + "\n")
+	for _, comment := range submission.Comments {
+		content.WriteString(labelStyle.Render(comment.AuthorName) + comment.CreatedAt.In(config.Location()).Format("Jan 2, 2006 3:04 PM") + "\n")
+		content.WriteString(textStyle.Render(comment.Comment) + "\n\n")
+	}
+
+	return content.String()
+}
+
+// runSubmissionsView displays a submission in a scrollable view
+func runSubmissionsView(courseID, assignmentID, userID string) {
+	model, err := runProgram(
+		NewSubmissionDetailModel(courseID, assignmentID, userID),
+		tea.WithAltScreen(),
+		tea.WithMouseCellMotion(),
+	)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running submission view: %v\n", err)
+		return
+	}
+
+	if m, ok := model.(SubmissionDetailModel); ok && m.err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching submission: %v\n", m.err)
+	}
+}
+
+// yesNo formats a boolean for display in a table cell
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+// gradeImportRow is one grade read from an import CSV
+type gradeImportRow struct {
+	line    int
+	userID  string
+	score   float64
+	comment string
+}
+
+// parseGradeCSV reads and validates the rows of a grade import CSV. It
+// returns a message for every invalid row so they can all be fixed at once.
+func parseGradeCSV(path string, pointsPossible float64) ([]gradeImportRow, []string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("%s is empty", path)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"user_id", "score"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("missing required column %q", required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []gradeImportRow
+	var problems []string
+	seen := make(map[string]int)
+	for i, record := range records[1:] {
+		// Line numbers count the header
+		row := gradeImportRow{
+			line:    i + 2,
+			userID:  field(record, "user_id"),
+			comment: field(record, "comment"),
+		}
+
+		if _, err := strconv.Atoi(row.userID); err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: invalid user_id %q", row.line, row.userID))
+		} else if first, ok := seen[row.userID]; ok {
+			problems = append(problems, fmt.Sprintf("line %d: user_id %s is repeated from line %d", row.line, row.userID, first))
+		} else {
+			seen[row.userID] = row.line
+		}
+
+		score := field(record, "score")
+		row.score, err = strconv.ParseFloat(score, 64)
+		switch {
+		case err != nil:
+			problems = append(problems, fmt.Sprintf("line %d: invalid score %q", row.line, score))
+		case row.score < 0:
+			problems = append(problems, fmt.Sprintf("line %d: score %s is negative", row.line, score))
+		case row.score > pointsPossible:
+			problems = append(problems, fmt.Sprintf("line %d: score %s is more than the %g points possible", row.line, score, pointsPossible))
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, problems, nil
+}
+
+// gradeImportResult is the outcome of submitting the grade of one row
+type gradeImportResult struct {
+	index int
+	err   error
+}
+
+// gradeImportModel shows a progress bar while grades are submitted by a pool
+// of workers, which report each row on results
+type gradeImportModel struct {
+	bar       ui.ProgressModel
+	results   <-chan gradeImportResult
+	total     int
+	done      int
+	completed bool // false if the user quit before every grade was submitted
+}
+
+// waitForResult returns a command that receives the next finished row
+func (m gradeImportModel) waitForResult() tea.Cmd {
+	return func() tea.Msg {
+		return <-m.results
+	}
+}
+
+func (m gradeImportModel) Init() tea.Cmd {
+	total := m.total
+	return tea.Batch(
+		func() tea.Msg { return ui.ProgressTickMsg{Total: total} },
+		m.waitForResult(),
+	)
+}
+
+func (m gradeImportModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c", "esc":
+			return m, tea.Quit
+		}
+		return m, nil
+
+	case gradeImportResult:
+		m.done++
+		if m.done == m.total {
+			m.completed = true
+			return m, tea.Quit
+		}
+		bar, _ := m.bar.Update(ui.ProgressTickMsg{Current: m.done, Total: m.total})
+		m.bar = bar.(ui.ProgressModel)
+		return m, m.waitForResult()
+	}
+
+	bar, cmd := m.bar.Update(msg)
+	m.bar = bar.(ui.ProgressModel)
+	return m, cmd
+}
+
+func (m gradeImportModel) View() string {
+	if m.completed {
+		return ""
+	}
+	return m.bar.View()
+}
+
+func runSubmissionsImport(courseID, assignmentID, csvFile string) {
+	client := newClient()
+	assignment, err := client.GetAssignment(courseID, assignmentID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching assignment: %v\n", err)
+		return
+	}
+
+	rows, problems, err := parseGradeCSV(csvFile, assignment.PointsPossible)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading CSV: %v\n", err)
+		return
+	}
+
+	submissions, err := client.GetSubmissions(courseID, assignmentID, 0, 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching submissions: %v\n", err)
+		return
+	}
+	existing := make(map[string]api.Submission, len(submissions))
+	for _, submission := range submissions {
+		existing[strconv.Itoa(submission.UserID)] = submission
+	}
+
+	// Rows for students without a submission would fail, so report them with
+	// the other problems; rows that would not change the grade are skipped
+	skipped := make([]bool, len(rows))
+	var pending []int
+	for i, row := range rows {
+		submission, ok := existing[row.userID]
+		if !ok {
+			if _, err := strconv.Atoi(row.userID); err == nil {
+				problems = append(problems, fmt.Sprintf("line %d: user_id %s has no submission for this assignment", row.line, row.userID))
+			}
+			continue
+		}
+		if submission.WorkflowState == "graded" && submission.Score == row.score && row.comment == "" {
+			skipped[i] = true
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	if len(problems) > 0 {
+		fmt.Fprintf(os.Stderr, "Error: %s has %d invalid rows:\n", csvFile, len(problems))
+		for _, problem := range problems {
+			fmt.Fprintln(os.Stderr, "   "+problem)
+		}
+		return
+	}
+	if len(rows) == 0 {
+		fmt.Printf("No grades in %s.\n", csvFile)
+		return
+	}
+
+	if api.DryRun {
+		fmt.Printf("%s is valid: %d grades would be submitted for %s (%d unchanged)\n",
+			csvFile, len(pending), assignment.Name, len(rows)-len(pending))
+		return
+	}
+
+	// Workers stop picking up rows once the user quits the progress bar
+	ctx, cancel := context.WithCancel(commandContext)
+	defer cancel()
+	workerClient := client.WithContext(ctx)
+
+	errs := make([]error, len(rows))
+	ran := make([]bool, len(rows))
+	results := make(chan gradeImportResult, len(pending))
+	sem := make(chan struct{}, maxConcurrency())
+	var wg sync.WaitGroup
+	for _, i := range pending {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			if ctx.Err() != nil {
+				return
+			}
+
+			row := rows[i]
+			score := strconv.FormatFloat(row.score, 'f', -1, 64)
+			_, err := workerClient.GradeSubmission(courseID, assignmentID, row.userID, score, row.comment)
+			errs[i], ran[i] = err, true
+			results <- gradeImportResult{index: i, err: err}
+		}(i)
+	}
+
+	completed := true
+	if len(pending) > 0 {
+		title := fmt.Sprintf("Grading %d submissions for %s", len(pending), assignment.Name)
+		model := gradeImportModel{bar: ui.NewProgressBar(title), results: results, total: len(pending)}
+		result, err := runProgram(model)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		}
+		if finalModel, ok := result.(gradeImportModel); ok {
+			completed = finalModel.completed
+		}
+	}
+	cancel()
+	wg.Wait()
+
+	successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	failedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	skippedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	summary := lgtable.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("240"))).
+		Headers("Line", "User ID", "Score", "Result").
+		StyleFunc(func(row, col int) lipgloss.Style {
+			return lipgloss.NewStyle().Padding(0, 1)
+		})
+	var graded, failed int
+	for i, row := range rows {
+		var status string
+		switch {
+		case skipped[i]:
+			status = skippedStyle.Render("Skipped (same score)")
+		case !ran[i]:
+			status = skippedStyle.Render("Not submitted")
+		case errs[i] != nil:
+			status = failedStyle.Render(errs[i].Error())
+			failed++
+		default:
+			status = successStyle.Render("Graded")
+			graded++
+		}
+		summary.Row(strconv.Itoa(row.line), row.userID, strconv.FormatFloat(row.score, 'f', -1, 64), status)
+	}
+
+	if !completed {
+		fmt.Println("\nStopped before every grade was submitted.")
+	}
+	fmt.Printf("\nImported grades for %s in course %s\n\n", assignment.Name, courseID)
+	fmt.Println(summary.Render())
+	fmt.Printf("✅ Graded: %d\n", graded)
+	fmt.Printf("⏭️  Skipped: %d\n", len(rows)-len(pending))
+	fmt.Printf("❌ Failed: %d\n", failed)
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+)
+
+func newCoursesSyllabusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "syllabus",
+		Short: "View and edit a course syllabus",
+		Long:  `View a course's syllabus, or edit its HTML in your editor.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(
+		newSyllabusViewCmd(),
+		newSyllabusEditCmd(),
+	)
+
+	return cmd
+}
+
+func newSyllabusViewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "view [course-id]",
+		Short: "View a course syllabus",
+		Long:  `View a course's syllabus, rendered from HTML, in a scrollable view.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runSyllabusView(args[0])
+		},
+	}
+}
+
+func newSyllabusEditCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "edit [course-id]",
+		Short: "Edit a course syllabus",
+		Long: `Open a course's syllabus HTML in your editor ($VISUAL or $EDITOR, or vi when
+neither is set) and save it to Canvas when the editor exits.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runSyllabusEdit(args[0])
+		},
+	}
+}
+
+func runSyllabusView(courseID string) {
+	client := newClient()
+	syllabus, err := client.GetSyllabus(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching syllabus: %v\n", err)
+		return
+	}
+
+	render := func(width int) string {
+		if strings.TrimSpace(syllabus) == "" {
+			return "This course has no syllabus."
+		}
+		return ui.RenderHTML(syllabus, width-6)
+	}
+
+	// Print plain text when the output is piped
+	if !stdoutIsTerminal() {
+		fmt.Println(render(80))
+		return
+	}
+
+	model := ui.NewViewportModel(fmt.Sprintf("Syllabus for Course %s", courseID), render)
+	if _, err := runProgram(model, tea.WithAltScreen(), tea.WithMouseCellMotion()); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running syllabus view: %v\n", err)
+	}
+}
+
+func runSyllabusEdit(courseID string) {
+	client := newClient()
+	syllabus, err := client.GetSyllabus(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching syllabus: %v\n", err)
+		return
+	}
+
+	edited, err := editInEditor(syllabus, "syllabus-*.html")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error editing syllabus: %v\n", err)
+		return
+	}
+
+	if edited == syllabus {
+		fmt.Println("No changes to save.")
+		return
+	}
+
+	if err := client.UpdateSyllabus(courseID, edited); err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating syllabus: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Successfully updated the syllabus of course %s\n", courseID)
+}
+
+// editInEditor writes content to a temporary file named after pattern, opens
+// it in the user's editor, and returns the file's content once the editor
+// exits. The editor is $VISUAL or $EDITOR, or vi when neither is set, and may
+// include arguments, such as "code --wait".
+func editInEditor(content, pattern string) (string, error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", err
+	}
+	path := f.Name()
+	defer os.Remove(path)
+
+	_, err = f.WriteString(content)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+	args := strings.Fields(editor)
+
+	cmd := exec.Command(args[0], append(args[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("running %s: %w", editor, err)
+	}
+
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(edited), nil
+}
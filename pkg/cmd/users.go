@@ -1,17 +1,26 @@
 package cmd
 
 import (
+	"encoding/csv"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/mail"
 	"os"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
 	"github.com/Reisender/canvas-cli-v2/pkg/ui"
-	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
+	lgtable "github.com/charmbracelet/lipgloss/table"
 	"github.com/spf13/cobra"
 )
 
@@ -30,57 +39,193 @@ func NewUsersCmd() *cobra.Command {
 	cmd.AddCommand(
 		newUsersListCmd(),
 		newUsersViewCmd(),
+		newUsersSearchCmd(),
+		newUsersFindBySISCmd(),
+		newUsersCreateCmd(),
 		newEnrollmentsCmd(),
 		newUsersRemoveCmd(),
+		newUsersExportCmd(),
 	)
 
 	return cmd
 }
 
+// usersListOptions holds the flags of the users list command
+type usersListOptions struct {
+	multiSelect         bool
+	includeLastActivity bool
+	activityAscending   bool
+	pagination          paginationOptions
+	watch               time.Duration
+}
+
 func newUsersListCmd() *cobra.Command {
-	var multiSelect bool
+	var opts usersListOptions
 
 	cmd := &cobra.Command{
 		Use:   "list [course-id]",
 		Short: "List users in a course",
-		Long:  `List all users enrolled in a specific Canvas course.`,
-		Args:  cobra.ExactArgs(1),
+		Long: `List all users enrolled in a specific Canvas course.
+
+With --include-last-activity, a Last Activity column is added and users are
+sorted with the most recently active first. Use --sort-by-activity-asc to list
+the least recently active first instead. Users who have never been active are
+always listed last.
+
+Results are fetched one page at a time; use --page and --per-page to choose
+the page, or --all to fetch every page.
+
+With --watch, the table is fetched again every 30 seconds, or at the interval
+given with --watch=10s, showing when it was last updated.`,
+		Args: cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
-			runUsersList(args[0], multiSelect)
+			if err := opts.pagination.validate(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return
+			}
+			if opts.activityAscending {
+				opts.includeLastActivity = true
+			}
+			runUsersList(args[0], opts)
 		},
 	}
 
-	cmd.Flags().BoolVarP(&multiSelect, "multi", "m", false, "Enable multi-selection mode")
+	cmd.Flags().BoolVarP(&opts.multiSelect, "multi", "m", false, "Enable multi-selection mode")
+	cmd.Flags().BoolVar(&opts.includeLastActivity, "include-last-activity", false, "Show and sort by each user's last activity in the course")
+	cmd.Flags().BoolVar(&opts.activityAscending, "sort-by-activity-asc", false, "Sort by last activity, least recent first (implies --include-last-activity)")
+	addPaginationFlags(cmd, &opts.pagination)
+	addWatchFlag(cmd, &opts.watch)
+	addBookmarkFlag(cmd, "course")
+	cmd.ValidArgsFunction = completeCourseIDs
 	return cmd
 }
 
 func newUsersViewCmd() *cobra.Command {
-	return &cobra.Command{
+	var includeAvatar bool
+
+	cmd := &cobra.Command{
 		Use:   "view [user-id]",
 		Short: "View a Canvas user",
 		Long:  `View details about a specific Canvas user.`,
 		Args:  cobra.ExactArgs(1),
-		Run:   runUsersView,
+		Run: func(cmd *cobra.Command, args []string) {
+			runUsersView(args[0], includeAvatar)
+		},
+	}
+
+	cmd.Flags().BoolVar(&includeAvatar, "include-avatar", false, "Fetch and display the user's avatar URL")
+	return cmd
+}
+
+func newUsersFindBySISCmd() *cobra.Command {
+	var includeAvatar bool
+
+	cmd := &cobra.Command{
+		Use:   "find-by-sis [sis-user-id]",
+		Short: "Find a user by SIS user ID",
+		Long:  `Look up a Canvas user by the ID your student information system (SIS) gave them, and show their details.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			runUsersFindBySIS(args[0], includeAvatar)
+		},
+	}
+
+	cmd.Flags().BoolVar(&includeAvatar, "include-avatar", false, "Fetch and display the user's avatar URL")
+	return cmd
+}
+
+func newUsersSearchCmd() *cobra.Command {
+	var accountID string
+
+	cmd := &cobra.Command{
+		Use:   "search [query]",
+		Short: "Search an account for users",
+		Long: `Search the users of a Canvas account by name, login ID, email, or SIS ID.
+The query must be at least 2 characters.
+
+By default the root account of your user is searched; use --account to search
+another account. Searching an account requires permission to view its users.
+
+Select a user to view their details.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len([]rune(args[0])) < 2 {
+				fmt.Fprintln(os.Stderr, "Error: the query must be at least 2 characters")
+				return
+			}
+			runUsersSearch(args[0], accountID)
+		},
+	}
+
+	cmd.Flags().StringVar(&accountID, "account", "self", "ID of the account to search")
+	return cmd
+}
+
+func newUsersCreateCmd() *cobra.Command {
+	var accountID string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a user in an account",
+		Long: `Create a Canvas user with interactive form input. The user's email address is
+used as their login ID.
+
+By default the user is sent an email asking them to choose a password when
+they first log in; otherwise enter a password for them. Leave the
+authentication provider empty for a Canvas login, or enter the ID of an
+SSO provider configured in the account.
+
+Creating users requires permission to manage users in the account; use
+--account to create the user in a sub-account.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			runUsersCreate(accountID)
+		},
 	}
+
+	cmd.Flags().StringVar(&accountID, "account", "self", "ID of the account to create the user in")
+	return cmd
 }
 
 func newUsersRemoveCmd() *cobra.Command {
-	return &cobra.Command{
+	var allRoles bool
+	var role string
+
+	cmd := &cobra.Command{
 		Use:   "remove [course-id] [user-id]",
 		Short: "Remove a user from a course",
-		Long:  `Remove a user from a Canvas course using the user ID.`,
-		Args:  cobra.ExactArgs(2),
+		Long: `Remove a user from a Canvas course using the user ID.
+
+A user can have several roles in a course, such as student and TA. Every one
+of their enrollments is removed unless --role names the one to remove.`,
+		Args: cobra.ExactArgs(2),
 		Run: func(cmd *cobra.Command, args []string) {
-			courseID := args[0]
-			userID := args[1]
+			runUsersRemove(args[0], args[1], role, allRoles)
+		},
+	}
 
-			client := api.NewClient()
-			if err := client.RemoveUserByID(courseID, userID); err != nil {
-				fmt.Fprintf(os.Stderr, "Error removing user: %v\n", err)
-				return
-			}
+	cmd.Flags().BoolVar(&allRoles, "all-roles", true, "Remove every enrollment the user has in the course")
+	cmd.Flags().StringVar(&role, "role", "", "Only remove the user's enrollment of this type, such as StudentEnrollment")
+	cmd.MarkFlagsMutuallyExclusive("all-roles", "role")
+
+	return cmd
+}
+
+func newUsersExportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "export [course-id] [output-file]",
+		Short: "Export users to CSV",
+		Long: `Export the users of a Canvas course to a CSV file with their role, section,
+grades, and activity time, one row per user.
 
-			fmt.Printf("Successfully removed user %s from course %s\n", userID, courseID)
+Writes to stdout when no output file is given or the output file is "-".`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			outputFile := "-"
+			if len(args) > 1 {
+				outputFile = args[1]
+			}
+			runUsersExport(args[0], outputFile)
 		},
 	}
 }
@@ -99,25 +244,45 @@ func newEnrollmentsCmd() *cobra.Command {
 	cmd.AddCommand(
 		newEnrollmentsListCmd(),
 		newEnrollmentsAddCmd(),
+		newEnrollmentsBulkImportCmd(),
 		newEnrollmentsRemoveCmd(),
+		newEnrollmentsConcludeCmd(),
+		newEnrollmentsDeactivateCmd(),
+		newEnrollmentsResendCmd(),
+		newEnrollmentsExportCmd(),
 	)
 
 	return cmd
 }
 
 func newEnrollmentsListCmd() *cobra.Command {
-	return &cobra.Command{
+	var pagination paginationOptions
+
+	cmd := &cobra.Command{
 		Use:   "list [course-id]",
 		Short: "List enrollments for a course",
-		Long:  `List all enrollments for a specific Canvas course.`,
-		Args:  cobra.ExactArgs(1),
-		Run:   runEnrollmentsList,
+		Long: `List all enrollments for a specific Canvas course.
+
+Results are fetched one page at a time; use --page and --per-page to choose
+the page, or --all to fetch every page.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := pagination.validate(); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return
+			}
+			runEnrollmentsList(args[0], pagination)
+		},
 	}
+
+	addPaginationFlags(cmd, &pagination)
+	return cmd
 }
 
 func newEnrollmentsAddCmd() *cobra.Command {
 	var enrollmentType string
 	var notify bool
+	var section string
 
 	cmd := &cobra.Command{
 		Use:   "add [course-id] [user-id]",
@@ -128,8 +293,19 @@ func newEnrollmentsAddCmd() *cobra.Command {
 			courseID := args[0]
 			userID := args[1]
 
-			client := api.NewClient()
-			enrollment, err := client.AddUserToCourse(courseID, userID, enrollmentType, notify)
+			client := newClient()
+
+			sectionID := ""
+			if section != "" {
+				var err error
+				sectionID, err = resolveSectionID(client, courseID, section)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+					return
+				}
+			}
+
+			enrollment, err := client.AddUserToCourse(courseID, userID, enrollmentType, sectionID, notify)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error enrolling user: %v\n", err)
 				return
@@ -144,10 +320,27 @@ func newEnrollmentsAddCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&enrollmentType, "type", "t", "StudentEnrollment",
 		"Enrollment type (StudentEnrollment, TeacherEnrollment, TaEnrollment, ObserverEnrollment, DesignerEnrollment)")
 	cmd.Flags().BoolVarP(&notify, "notify", "n", false, "Send enrollment notification to the user")
+	cmd.Flags().StringVarP(&section, "section", "s", "", "Enroll the user in this section, by ID or name")
 
 	return cmd
 }
 
+func newEnrollmentsBulkImportCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "bulk-import [course-id] [csv-file]",
+		Short: "Enroll users from a CSV file",
+		Long: `Enroll every user listed in a CSV file in a course.
+
+The CSV needs a header row with the columns user_id and type, and may also
+have section_id and notify (true or false). Every row is checked before
+anyone is enrolled, and --dry-run stops after the check.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runEnrollmentsBulkImport(args[0], args[1])
+		},
+	}
+}
+
 func newEnrollmentsRemoveCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "remove [course-id] [enrollment-id]",
@@ -158,7 +351,7 @@ func newEnrollmentsRemoveCmd() *cobra.Command {
 			courseID := args[0]
 			enrollmentID := args[1]
 
-			client := api.NewClient()
+			client := newClient()
 			if err := client.RemoveUserFromCourse(courseID, enrollmentID); err != nil {
 				fmt.Fprintf(os.Stderr, "Error removing enrollment: %v\n", err)
 				return
@@ -169,6 +362,220 @@ func newEnrollmentsRemoveCmd() *cobra.Command {
 	}
 }
 
+func newEnrollmentsConcludeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "conclude [course-id] [enrollment-id]",
+		Short: "Conclude an enrollment",
+		Long:  `Conclude a user's enrollment, ending their participation in the course while keeping their grades.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runEnrollmentTask(args[0], args[1], "Conclude", "concluded", func(client *api.Client) error {
+				return client.ConcludeEnrollment(args[0], args[1])
+			})
+		},
+	}
+}
+
+func newEnrollmentsDeactivateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "deactivate [course-id] [enrollment-id]",
+		Short: "Deactivate an enrollment",
+		Long:  `Deactivate a user's enrollment, hiding the course from them while keeping the enrollment so it can be reactivated.`,
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			runEnrollmentTask(args[0], args[1], "Deactivate", "deactivated", func(client *api.Client) error {
+				return client.DeactivateEnrollment(args[0], args[1])
+			})
+		},
+	}
+}
+
+func newEnrollmentsResendCmd() *cobra.Command {
+	var allPending bool
+
+	cmd := &cobra.Command{
+		Use:   "resend [course-id] [enrollment-id]",
+		Short: "Resend enrollment invitations",
+		Long: `Resend the invitation of a pending enrollment, one whose user has not yet
+accepted it. With --all-pending, invitations are resent for every pending
+enrollment in the course and no enrollment ID is needed.`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			if allPending == (len(args) == 2) {
+				fmt.Fprintln(os.Stderr, "Error: give either an enrollment ID or --all-pending")
+				return
+			}
+			if allPending {
+				runEnrollmentsResendAll(args[0])
+				return
+			}
+			runEnrollmentsResend(args[0], args[1])
+		},
+	}
+
+	cmd.Flags().BoolVar(&allPending, "all-pending", false, "Resend the invitations of every pending enrollment in the course")
+
+	return cmd
+}
+
+func runEnrollmentsResend(courseID, enrollmentID string) {
+	client := newClient()
+	enrollments, err := client.GetEnrollments(courseID, 0, 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching enrollments: %v\n", err)
+		return
+	}
+
+	var enrollment *api.Enrollment
+	for i := range enrollments {
+		if strconv.Itoa(enrollments[i].ID) == enrollmentID {
+			enrollment = &enrollments[i]
+			break
+		}
+	}
+	if enrollment == nil {
+		fmt.Fprintf(os.Stderr, "Error: enrollment %s not found in course %s\n", enrollmentID, courseID)
+		return
+	}
+	if enrollment.EnrollmentState != "invited" {
+		fmt.Fprintf(os.Stderr, "Error: %s's enrollment is %s, not pending an invitation\n", enrollment.User.Name, enrollment.EnrollmentState)
+		return
+	}
+
+	if err := client.ResendEnrollmentInvitation(courseID, *enrollment); err != nil {
+		fmt.Fprintf(os.Stderr, "Error resending invitation: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Successfully resent %s's invitation to course %s\n", enrollment.User.Name, courseID)
+}
+
+func runEnrollmentsResendAll(courseID string) {
+	client := newClient()
+	enrollments, err := client.GetEnrollments(courseID, 0, 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching enrollments: %v\n", err)
+		return
+	}
+
+	var pending []api.Enrollment
+	for _, enrollment := range enrollments {
+		if enrollment.EnrollmentState == "invited" {
+			pending = append(pending, enrollment)
+		}
+	}
+	if len(pending) == 0 {
+		fmt.Printf("No pending enrollments in course %s\n", courseID)
+		return
+	}
+
+	labels := make([]string, len(pending))
+	for i, enrollment := range pending {
+		labels[i] = fmt.Sprintf("%s (%s)", enrollment.User.Name, enrollment.Role)
+	}
+
+	title := fmt.Sprintf("Resending %d invitations in course %s", len(pending), courseID)
+	model := ui.NewProgressModel(title, labels, func(i int) error {
+		return client.ResendEnrollmentInvitation(courseID, pending[i])
+	})
+
+	result, err := runProgram(model)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		return
+	}
+
+	finalModel, ok := result.(ui.ProgressModel)
+	if !ok {
+		return
+	}
+
+	if !finalModel.Completed {
+		fmt.Println("\nStopped before every invitation was resent.")
+	}
+	fmt.Printf("\nResent %d of %d invitations in course %s\n", finalModel.Success, len(pending), courseID)
+	fmt.Printf("✅ Success: %d\n", finalModel.Success)
+	fmt.Printf("❌ Failed: %d\n", len(finalModel.Failed))
+	for _, failure := range finalModel.Failed {
+		fmt.Printf("  %s\n", failure)
+	}
+}
+
+// runEnrollmentTask confirms and then performs a change to an enrollment,
+// such as concluding it. action names the change in the prompt ("Conclude")
+// and done in the result ("concluded").
+func runEnrollmentTask(courseID, enrollmentID, action, done string, task func(client *api.Client) error) {
+	client := newClient()
+	enrollments, err := client.GetEnrollments(courseID, 0, 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching enrollments: %v\n", err)
+		return
+	}
+
+	var enrollment *api.Enrollment
+	for i := range enrollments {
+		if strconv.Itoa(enrollments[i].ID) == enrollmentID {
+			enrollment = &enrollments[i]
+			break
+		}
+	}
+	if enrollment == nil {
+		fmt.Fprintf(os.Stderr, "Error: enrollment %s not found in course %s\n", enrollmentID, courseID)
+		return
+	}
+
+	confirmed := false
+	if err := huh.NewConfirm().
+		Title(fmt.Sprintf("%s %s's %s in course %s?", action, enrollment.User.Name, enrollment.Role, courseID)).
+		Affirmative(action).
+		Negative("Cancel").
+		Value(&confirmed).
+		WithTheme(huh.ThemeBase16()).
+		Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+	if !confirmed {
+		fmt.Println("Cancelled.")
+		return
+	}
+
+	if err := task(client); err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating enrollment: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Successfully %s %s's enrollment in course %s\n", done, enrollment.User.Name, courseID)
+}
+
+func newEnrollmentsExportCmd() *cobra.Command {
+	var activeOnly bool
+	var studentsOnly bool
+	var includeGrades bool
+
+	cmd := &cobra.Command{
+		Use:   "export [course-id] [output-file]",
+		Short: "Export enrollments to CSV",
+		Long: `Export all enrollments for a Canvas course to a CSV file.
+
+Writes to stdout when no output file is given or the output file is "-".`,
+		Args: cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			outputFile := "-"
+			if len(args) > 1 {
+				outputFile = args[1]
+			}
+			runEnrollmentsExport(args[0], outputFile, activeOnly, studentsOnly, includeGrades)
+		},
+	}
+
+	cmd.Flags().BoolVar(&activeOnly, "active-only", false, "Only export active enrollments")
+	cmd.Flags().BoolVar(&studentsOnly, "students-only", false, "Only export student enrollments")
+	cmd.Flags().BoolVar(&includeGrades, "include-grades", false, "Include current and final grades")
+
+	return cmd
+}
+
 // UserActionModel represents the model for the user action selection screen
 type UserActionModel struct {
 	courseID  string
@@ -179,15 +586,46 @@ type UserActionModel struct {
 	client    *api.Client
 	completed bool
 	result    string
+	confirm   *ui.ConfirmModel // shown before removing the user
+	width     int
 }
 
+// Messages sent by the remove confirmation dialog
+type removeUserConfirmedMsg struct{}
+type removeUserCancelledMsg struct{}
+
 func (m UserActionModel) Init() tea.Cmd {
 	return nil
 }
 
 func (m UserActionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+
+	case removeUserConfirmedMsg:
+		m.confirm = nil
+		err := m.client.RemoveUserByID(m.courseID, m.userID)
+		if err != nil {
+			m.result = fmt.Sprintf("Error removing user: %v", err)
+		} else {
+			m.result = fmt.Sprintf("Successfully removed user %s (%s) from course %s",
+				m.userID, m.userName, m.courseID)
+		}
+		m.completed = true
+		return m, tea.Quit
+
+	case removeUserCancelledMsg:
+		m.confirm = nil
+
 	case tea.KeyMsg:
+		if m.confirm != nil {
+			confirm, cmd := m.confirm.Update(msg)
+			c := confirm.(ui.ConfirmModel)
+			m.confirm = &c
+			return m, cmd
+		}
+
 		switch msg.String() {
 		case "q", "ctrl+c", "esc":
 			return m, tea.Quit
@@ -228,16 +666,17 @@ func (m UserActionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.completed = true
 				return m, tea.Quit
 			} else if m.cursor == 1 {
-				// Remove user
-				err := m.client.RemoveUserByID(m.courseID, m.userID)
-				if err != nil {
-					m.result = fmt.Sprintf("Error removing user: %v", err)
-				} else {
-					m.result = fmt.Sprintf("Successfully removed user %s (%s) from course %s",
-						m.userID, m.userName, m.courseID)
-				}
-				m.completed = true
-				return m, tea.Quit
+				// Remove user, once confirmed
+				confirm := ui.NewConfirmModel(
+					fmt.Sprintf("Are you sure you want to remove %s (ID %s) from the course? [y/N]", m.userName, m.userID),
+					func() tea.Msg { return removeUserConfirmedMsg{} },
+					func() tea.Msg { return removeUserCancelledMsg{} },
+				)
+				confirm.YesLabel = "Remove"
+				confirm.NoLabel = "Cancel"
+				confirm.Width = m.width
+				m.confirm = &confirm
+				return m, nil
 			} else {
 				// Cancel
 				return m, tea.Quit
@@ -252,6 +691,10 @@ func (m UserActionModel) View() string {
 		return m.result
 	}
 
+	if m.confirm != nil {
+		return "\n" + m.confirm.View() + "\n"
+	}
+
 	s := fmt.Sprintf("\nUser: %s (ID: %s)\n\n", m.userName, m.userID)
 	s += "What would you like to do?\n\n"
 
@@ -280,14 +723,28 @@ type MultiActionModel struct {
 	total         int
 	success       int
 	failed        int
-	processing    bool           // Flag to indicate removal in progress
-	progressBar   progress.Model // Charmbracelet progress bar
+	processing    bool             // Flag to indicate removal in progress
+	progressBar   ui.ProgressModel // Shows how many users have been removed
 }
 
 func (m MultiActionModel) Init() tea.Cmd {
 	return nil
 }
 
+// removeUser returns a command that removes the selected user at index
+func (m MultiActionModel) removeUser(index int) tea.Cmd {
+	return func() tea.Msg {
+		err := m.client.RemoveUserByID(m.courseID, m.selectedUsers[index][0])
+		return userRemovalProgressMsg{index: index, err: err}
+	}
+}
+
+// userLabel names the selected user at index for the progress display
+func (m MultiActionModel) userLabel(index int) string {
+	row := m.selectedUsers[index]
+	return fmt.Sprintf("%s (%s)", row[1], row[0])
+}
+
 func (m MultiActionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
@@ -303,75 +760,41 @@ func (m MultiActionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.cursor++
 			}
 		case "enter":
-			if m.cursor == 0 && !m.processing {
+			if m.cursor == 0 && !m.processing && len(m.selectedUsers) > 0 {
 				// Start removing users
 				m.total = len(m.selectedUsers)
 				m.processing = true
+				m.progressBar = ui.NewProgressBar(fmt.Sprintf("Removing %d users from course %s", m.total, m.courseID))
 
-				// Initialize the progress bar
-				p := progress.New(
-					progress.WithDefaultGradient(),
-					progress.WithWidth(40),
-					progress.WithoutPercentage(),
-				)
-				m.progressBar = p
-
-				// Return the model immediately to show the progress bar
-				return m, func() tea.Msg {
-					return userRemovalStartMsg{}
-				}
+				tick := ui.ProgressTickMsg{Current: 0, Total: m.total, Label: m.userLabel(0)}
+				return m, tea.Sequence(func() tea.Msg { return tick }, m.removeUser(0))
 			} else if !m.processing {
 				// Cancel
 				return m, tea.Quit
 			}
 		}
-	case userRemovalStartMsg:
-		return m, func() tea.Msg {
-			return userRemovalProgressMsg{
-				index: 0,
-			}
-		}
-	case userRemovalProgressMsg:
-		if m.progress >= m.total {
-			// All done, show results
-			var results strings.Builder
-			results.WriteString(fmt.Sprintf("\nRemoved %d users from course %s\n\n", m.total, m.courseID))
-			results.WriteString(fmt.Sprintf("✅ Success: %d\n", m.success))
-			results.WriteString(fmt.Sprintf("❌ Failed: %d\n", m.failed))
-
-			m.result = results.String()
-			m.completed = true
-			m.processing = false
-			return m, tea.Quit
-		}
 
-		// Process the next user
-		idx := msg.index
-		row := m.selectedUsers[idx]
-		userID := row[0]
-		userName := row[1]
-
-		// Display who's being processed in the result field
-		m.result = fmt.Sprintf("Processing: %s (%s)", userName, userID)
+	case ui.ProgressTickMsg:
+		bar, cmd := m.progressBar.Update(msg)
+		m.progressBar = bar.(ui.ProgressModel)
+		return m, cmd
 
-		err := m.client.RemoveUserByID(m.courseID, userID)
-		if err != nil {
+	case userRemovalProgressMsg:
+		if msg.err != nil {
 			m.failed++
 		} else {
 			m.success++
 		}
 		m.progress++
 
-		// Process the next user or finish
+		// Report the removal and move on to the next user, if any
+		tick := ui.ProgressTickMsg{Current: m.progress, Total: m.total}
 		if m.progress < m.total {
-			return m, func() tea.Msg {
-				return userRemovalProgressMsg{
-					index: idx + 1,
-				}
-			}
+			tick.Label = m.userLabel(msg.index + 1)
+			return m, tea.Sequence(func() tea.Msg { return tick }, m.removeUser(msg.index+1))
 		}
 
-		// All done, update the view with the results
+		// All done, show results
 		var results strings.Builder
 		results.WriteString(fmt.Sprintf("\nRemoved %d users from course %s\n\n", m.total, m.courseID))
 		results.WriteString(fmt.Sprintf("✅ Success: %d\n", m.success))
@@ -380,17 +803,7 @@ func (m MultiActionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.result = results.String()
 		m.completed = true
 		m.processing = false
-		return m, nil
-	}
-
-	// Update progress bar if we're processing
-	if m.processing {
-		var cmd tea.Cmd
-		progressModel, cmd := m.progressBar.Update(msg)
-		if updatedProgressBar, ok := progressModel.(progress.Model); ok {
-			m.progressBar = updatedProgressBar
-		}
-		return m, cmd
+		return m, tea.Quit
 	}
 
 	return m, nil
@@ -402,25 +815,11 @@ func (m MultiActionModel) View() string {
 	}
 
 	if m.processing {
-		s := fmt.Sprintf("\nRemoving %d users from course %s\n\n", m.total, m.courseID)
-
-		// Calculate progress percentage
-		percent := float64(m.progress) / float64(m.total)
-
-		// Render the progress bar
-		s += m.progressBar.ViewAs(percent) + "\n"
-		s += fmt.Sprintf("%d/%d (%d%%)\n\n", m.progress, m.total, int(percent*100))
-
+		s := m.progressBar.View() + "\n"
 		if m.progress > 0 {
 			s += fmt.Sprintf("✅ Success: %d\n", m.success)
 			s += fmt.Sprintf("❌ Failed: %d\n", m.failed)
 		}
-
-		// Display current user being processed
-		if !m.completed && m.result != "" {
-			s += "\n" + m.result + "\n"
-		}
-
 		return s
 	}
 
@@ -439,64 +838,299 @@ func (m MultiActionModel) View() string {
 	return s
 }
 
-// Message types for handling asynchronous user removal
-type userRemovalStartMsg struct{}
+// userRemovalProgressMsg reports the result of removing one selected user
 type userRemovalProgressMsg struct {
 	index int
+	err   error
 }
 
-func runUsersList(courseID string, multiSelect bool) {
-	client := api.NewClient()
-
-	// Initialize variables for pagination
-	var allUsers []api.User
-	page := 1
-	perPage := 50
-	moreUsers := true
-
-	// Fetch users with pagination
-	for moreUsers {
-		users, err := client.GetUsers(courseID, page, perPage)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error fetching users: %v\n", err)
-			return
-		}
+// fetchUsers fetches the users in a course selected by pagination
+func fetchUsers(client *api.Client, courseID string, pagination paginationOptions) ([]api.User, error) {
+	return fetchPages(pagination, func(page, perPage int) ([]api.User, error) {
+		return client.GetUsers(courseID, page, perPage)
+	})
+}
 
-		// Add users to our collection
-		allUsers = append(allUsers, users...)
+// fetchAllUsers fetches every user in a course, following pagination
+func fetchAllUsers(client *api.Client, courseID string) ([]api.User, error) {
+	return fetchUsers(client, courseID, paginationOptions{perPage: defaultPerPage, all: true})
+}
 
-		// If we got fewer users than requested, we've reached the end
-		if len(users) < perPage {
-			moreUsers = false
-		} else {
-			page++
-		}
+// fetchLastActivity returns the most recent activity time of each user in a course
+func fetchLastActivity(client *api.Client, courseID string) (map[int]time.Time, error) {
+	enrollments, err := client.GetEnrollments(courseID, 0, 0)
+	if err != nil {
+		return nil, err
 	}
 
-	// If no users found
-	if len(allUsers) == 0 {
-		fmt.Println("No users found for this course.")
-		return
+	// Users can have several enrollments, so keep the latest activity
+	lastActivity := make(map[int]time.Time, len(enrollments))
+	for _, enrollment := range enrollments {
+		if enrollment.LastActivityAt.After(lastActivity[enrollment.UserID]) {
+			lastActivity[enrollment.UserID] = enrollment.LastActivityAt
+		}
 	}
+	return lastActivity, nil
+}
 
-	// Create a table for users
-	columns := []table.Column{
-		{Title: "ID", Width: 10},
-		{Title: "Name", Width: 30},
-		{Title: "Email", Width: 30},
-		{Title: "Login ID", Width: 15},
+// sortUsersByActivity sorts users by last activity, most recent first unless
+// ascending is set. Users who have never been active are always placed last.
+func sortUsersByActivity(users []api.User, lastActivity map[int]time.Time, ascending bool) {
+	sort.SliceStable(users, func(i, j int) bool {
+		a, b := lastActivity[users[i].ID], lastActivity[users[j].ID]
+		if a.IsZero() || b.IsZero() {
+			return !a.IsZero() && b.IsZero()
+		}
+		if ascending {
+			return a.Before(b)
+		}
+		return a.After(b)
+	})
+}
+
+// userRows builds the users list table rows, adding a Last Activity column
+// when lastActivity is not nil
+func userRows(users []api.User, lastActivity map[int]time.Time) []table.Row {
+	rows := []table.Row{}
+	for _, user := range users {
+		row := table.Row{
+			fmt.Sprintf("%d", user.ID),
+			user.Name,
+			user.Email,
+			user.LoginID,
+		}
+		if lastActivity != nil {
+			activity := "Never"
+			if at := lastActivity[user.ID]; !at.IsZero() {
+				activity = at.In(config.Location()).Format("Jan 2, 2006 3:04 PM")
+			}
+			row = append(row, activity)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// validateEmail checks an email address entered on the user form
+func validateEmail(s string) error {
+	address, err := mail.ParseAddress(s)
+	if err != nil || address.Address != s {
+		return fmt.Errorf("enter an email address such as name@example.edu")
+	}
+	return nil
+}
+
+func runUsersCreate(accountID string) {
+	var (
+		name                string
+		email               string
+		sisUserID           string
+		authProviderID      string
+		forcePasswordChange = true
+		password            string
+	)
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewNote().
+				Title("Create New User").
+				Description("Fill out the details for the new user"),
+
+			huh.NewInput().
+				Title("Name").
+				Prompt("> ").
+				Placeholder("Full name").
+				Validate(func(s string) error {
+					if strings.TrimSpace(s) == "" {
+						return fmt.Errorf("name is required")
+					}
+					return nil
+				}).
+				Value(&name),
+
+			huh.NewInput().
+				Title("Email").
+				Description("Also used as the login ID").
+				Prompt("> ").
+				Placeholder("name@example.edu").
+				Validate(validateEmail).
+				Value(&email),
+
+			huh.NewInput().
+				Title("SIS User ID").
+				Prompt("> ").
+				Placeholder("Optional").
+				Value(&sisUserID),
+
+			huh.NewInput().
+				Title("Authentication Provider ID").
+				Prompt("> ").
+				Placeholder("Optional, leave empty for a Canvas login").
+				Validate(func(s string) error {
+					if s == "" {
+						return nil
+					}
+					if _, err := strconv.Atoi(s); err != nil {
+						return fmt.Errorf("provider ID must be a number")
+					}
+					return nil
+				}).
+				Value(&authProviderID),
+
+			huh.NewConfirm().
+				Title("Require a password change on first login?").
+				Description("Email the user a link to choose their own password").
+				Value(&forcePasswordChange),
+		),
+
+		// Only ask for a password when the user will not choose their own
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Password").
+				Prompt("> ").
+				EchoMode(huh.EchoModePassword).
+				Validate(func(s string) error {
+					if len(s) < 8 {
+						return fmt.Errorf("password must be at least 8 characters")
+					}
+					return nil
+				}).
+				Value(&password),
+		).WithHideFunc(func() bool {
+			return forcePasswordChange
+		}),
+	).WithTheme(huh.ThemeBase16())
+
+	if err := form.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	user := &api.User{
+		Name:      strings.TrimSpace(name),
+		SISUserID: sisUserID,
+	}
+
+	client := newClient()
+	created, err := client.CreateUser(accountID, user, email, password, authProviderID, forcePasswordChange)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating user: %v\n", err)
+		return
+	}
+
+	fmt.Println("\n✅ User created successfully!")
+	fmt.Printf("ID: %d\n", created.ID)
+}
+
+func runUsersSearch(query, accountID string) {
+	client := newClient()
+	users, err := client.SearchUsers(query, accountID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error searching users: %v\n", err)
+		return
 	}
 
 	rows := []table.Row{}
-	for _, user := range allUsers {
+	for _, user := range users {
 		rows = append(rows, table.Row{
-			fmt.Sprintf("%d", user.ID),
+			strconv.Itoa(user.ID),
 			user.Name,
 			user.Email,
 			user.LoginID,
+			user.SISUserID,
 		})
 	}
 
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Name", Width: 30},
+		{Title: "Email", Width: 30},
+		{Title: "Login ID", Width: 20},
+		{Title: "SIS ID", Width: 15},
+	}
+
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	if len(users) == 0 {
+		fmt.Printf("No users found for %q.\n", query)
+		return
+	}
+
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+	t.SetStyles(ui.DefaultTableStyles())
+
+	m := ui.NewTableModel(t)
+	m.Title = fmt.Sprintf("Users Matching %q", query)
+	m.Help = "↑/↓: Navigate • enter: View User • q: Quit"
+	m.EnableFilter(-1)
+
+	// Remember the selected user so their details can be shown after the table closes
+	var selectedID string
+	m.QuitOnSelect = true
+	m.OnSelect = func(row table.Row) {
+		selectedID = row[0]
+	}
+
+	if _, err := runProgram(m); err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		os.Exit(1)
+	}
+
+	if selectedID != "" {
+		runUsersView(selectedID, false)
+	}
+}
+
+func runUsersList(courseID string, opts usersListOptions) {
+	client := newClient()
+	multiSelect := opts.multiSelect
+	pagination := opts.pagination
+
+	allUsers, err := fetchUsers(client, courseID, pagination)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching users: %v\n", err)
+		return
+	}
+
+	var lastActivity map[int]time.Time
+	if opts.includeLastActivity {
+		lastActivity, err = fetchLastActivity(client, courseID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching enrollments: %v\n", err)
+			return
+		}
+		sortUsersByActivity(allUsers, lastActivity, opts.activityAscending)
+	}
+
+	// Create a table for users
+	columns := []table.Column{
+		{Title: "ID", Width: 10},
+		{Title: "Name", Width: 30},
+		{Title: "Email", Width: 30},
+		{Title: "Login ID", Width: 15},
+	}
+	if opts.includeLastActivity {
+		columns = append(columns, table.Column{Title: "Last Activity", Width: 20})
+	}
+
+	rows := userRows(allUsers, lastActivity)
+	if writeOutput(columns, rows) {
+		return
+	}
+
+	// If no users found
+	if len(allUsers) == 0 {
+		fmt.Println("No users found for this course.")
+		return
+	}
+
 	t := table.New(
 		table.WithColumns(columns),
 		table.WithRows(rows),
@@ -504,20 +1138,14 @@ func runUsersList(courseID string, multiSelect bool) {
 		table.WithHeight(15),
 	)
 
-	s := table.DefaultStyles()
-	s.Header = s.Header.
-		BorderStyle(lipgloss.NormalBorder()).
-		BorderForeground(lipgloss.Color("240")).
-		BorderBottom(true).
-		Bold(true)
-	s.Selected = s.Selected.
-		Foreground(lipgloss.Color("229")).
-		Background(lipgloss.Color("57")).
-		Bold(true)
-	t.SetStyles(s)
+	t.SetStyles(ui.DefaultTableStyles())
 
 	m := ui.NewTableModel(t)
-	m.Title = fmt.Sprintf("Users in Course %s (%d users total)", courseID, len(allUsers))
+	if pagination.all {
+		m.Title = fmt.Sprintf("Users in Course %s (%d users total)", courseID, len(allUsers))
+	} else {
+		m.Title = fmt.Sprintf("Users in Course %s", courseID)
+	}
 
 	if multiSelect {
 		m.EnableMultiSelect()
@@ -536,8 +1164,7 @@ func runUsersList(courseID string, multiSelect bool) {
 			}
 
 			// Run the action program
-			p := tea.NewProgram(actionModel)
-			result, err := p.Run()
+			result, err := runProgram(actionModel)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error running action program: %v\n", err)
 				return
@@ -571,8 +1198,7 @@ func runUsersList(courseID string, multiSelect bool) {
 			}
 
 			// Run the action program
-			p := tea.NewProgram(actionModel)
-			result, err := p.Run()
+			result, err := runProgram(actionModel)
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error running action program: %v\n", err)
 				return
@@ -585,16 +1211,65 @@ func runUsersList(courseID string, multiSelect bool) {
 			}
 		}
 	}
+	m.EnableFilter(-1)
+
+	// Large courses can have thousands of users
+	m.VirtualScroll = true
 
-	if _, err := tea.NewProgram(m).Run(); err != nil {
+	fetchPage := func(page int, activity map[int]time.Time) ([]table.Row, error) {
+		pageOpts := pagination
+		pageOpts.page = page
+		users, err := fetchUsers(client, courseID, pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		if opts.includeLastActivity {
+			sortUsersByActivity(users, activity, opts.activityAscending)
+		}
+		return userRows(users, activity), nil
+	}
+	if !pagination.all {
+		m.EnablePaging(pagination.page, func(page int) ([]table.Row, error) {
+			return fetchPage(page, lastActivity)
+		})
+	}
+
+	// Refreshes also pick up new activity
+	watchTable(m, client, opts.watch, func(page int) ([]table.Row, error) {
+		activity := lastActivity
+		if opts.includeLastActivity {
+			var err error
+			activity, err = fetchLastActivity(client, courseID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return fetchPage(page, activity)
+	})
+
+	if _, err := runProgram(m); err != nil {
 		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
 		os.Exit(1)
 	}
 }
 
-func runUsersView(cmd *cobra.Command, args []string) {
-	userID := args[0]
-	client := api.NewClient()
+func runUsersFindBySIS(sisUserID string, includeAvatar bool) {
+	user, err := newClient().GetUserBySIS(sisUserID)
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		fmt.Fprintf(os.Stderr, "No user with SIS ID '%s' found\n", sisUserID)
+		return
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error finding user: %v\n", err)
+		return
+	}
+
+	runUsersView(strconv.Itoa(user.ID), includeAvatar)
+}
+
+func runUsersView(userID string, includeAvatar bool) {
+	client := newClient()
 	user, err := client.GetUserDetails(userID)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error fetching user details: %v\n", err)
@@ -611,18 +1286,49 @@ func runUsersView(cmd *cobra.Command, args []string) {
 	fmt.Printf("Email:        %s\n", user.Email)
 	fmt.Printf("Login ID:     %s\n", user.LoginID)
 	fmt.Printf("SIS User ID:  %s\n", user.SISUserID)
-	if user.Avatar != "" {
-		fmt.Printf("Avatar URL:   %s\n", user.Avatar)
-	}
 	if user.Locale != "" {
 		fmt.Printf("Locale:       %s\n", user.Locale)
 	}
+
+	// The avatar lives on the profile, so only fetch it when asked to
+	if includeAvatar {
+		profile, err := client.GetUserProfile(userID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching user avatar: %v\n", err)
+			return
+		}
+		if profile.AvatarURL != "" {
+			fmt.Printf("Avatar URL:   %s\n", ui.Hyperlink(profile.AvatarURL, profile.AvatarURL))
+		}
+	}
 }
 
-func runEnrollmentsList(cmd *cobra.Command, args []string) {
-	courseID := args[0]
-	client := api.NewClient()
-	enrollments, err := client.GetEnrollments(courseID)
+// fetchEnrollmentRows fetches the enrollments of a course and builds their table rows
+func fetchEnrollmentRows(client *api.Client, courseID string, pagination paginationOptions) ([]table.Row, error) {
+	enrollments, err := fetchPages(pagination, func(page, perPage int) ([]api.Enrollment, error) {
+		return client.GetEnrollments(courseID, page, perPage)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []table.Row{}
+	for _, enrollment := range enrollments {
+		rows = append(rows, table.Row{
+			strconv.Itoa(enrollment.ID),
+			strconv.Itoa(enrollment.UserID),
+			enrollment.User.Name,
+			enrollment.Role,
+			enrollment.EnrollmentState,
+		})
+	}
+
+	return rows, nil
+}
+
+func runEnrollmentsList(courseID string, pagination paginationOptions) {
+	client := newClient()
+	rows, err := fetchEnrollmentRows(client, courseID, pagination)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error fetching enrollments: %v\n", err)
 		return
@@ -637,15 +1343,8 @@ func runEnrollmentsList(cmd *cobra.Command, args []string) {
 		{Title: "Status", Width: 10},
 	}
 
-	rows := []table.Row{}
-	for _, enrollment := range enrollments {
-		rows = append(rows, table.Row{
-			strconv.Itoa(enrollment.ID),
-			strconv.Itoa(enrollment.UserID),
-			enrollment.User.Name,
-			enrollment.Role,
-			enrollment.EnrollmentState,
-		})
+	if writeOutput(columns, rows) {
+		return
 	}
 
 	t := table.New(
@@ -655,24 +1354,445 @@ func runEnrollmentsList(cmd *cobra.Command, args []string) {
 		table.WithHeight(15),
 	)
 
-	s := table.DefaultStyles()
-	s.Header = s.Header.
-		BorderStyle(lipgloss.NormalBorder()).
-		BorderForeground(lipgloss.Color("240")).
-		BorderBottom(true).
-		Bold(true)
-	s.Selected = s.Selected.
-		Foreground(lipgloss.Color("229")).
-		Background(lipgloss.Color("57")).
-		Bold(true)
-	t.SetStyles(s)
+	t.SetStyles(ui.DefaultTableStyles())
 
 	m := ui.NewTableModel(t)
 	m.Title = fmt.Sprintf("Enrollments for Course %s", courseID)
 	m.Help = "↑/↓: Navigate • enter: Select • q: Quit"
 
-	if _, err := tea.NewProgram(m).Run(); err != nil {
+	if !pagination.all {
+		m.EnablePaging(pagination.page, func(page int) ([]table.Row, error) {
+			pageOpts := pagination
+			pageOpts.page = page
+			return fetchEnrollmentRows(client, courseID, pageOpts)
+		})
+	}
+
+	if _, err := runProgram(m); err != nil {
 		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
 		os.Exit(1)
 	}
 }
+
+func runUsersRemove(courseID, userID, role string, allRoles bool) {
+	if role != "" && !slices.Contains(enrollmentTypes, role) {
+		fmt.Fprintf(os.Stderr, "Error: invalid role %q, must be one of %s\n", role, strings.Join(enrollmentTypes, ", "))
+		return
+	}
+
+	client := newClient()
+
+	// Without --all-roles, only remove the user when the role is unambiguous
+	if role == "" && !allRoles {
+		enrollments, err := client.GetEnrollments(courseID, 0, 0)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error fetching enrollments: %v\n", err)
+			return
+		}
+		var roles []string
+		for _, enrollment := range enrollments {
+			if strconv.Itoa(enrollment.UserID) == userID {
+				roles = append(roles, enrollment.Type)
+			}
+		}
+		if len(roles) > 1 {
+			fmt.Fprintf(os.Stderr, "Error: user %s has %d enrollments in course %s (%s); use --role to choose one\n",
+				userID, len(roles), courseID, strings.Join(roles, ", "))
+			return
+		}
+	}
+
+	removed, err := client.RemoveUserEnrollments(courseID, userID, role)
+	if err != nil {
+		for _, enrollment := range removed {
+			fmt.Printf("Removed %s %d\n", enrollment.Type, enrollment.ID)
+		}
+		fmt.Fprintf(os.Stderr, "Error removing user: %v\n", err)
+		return
+	}
+
+	roles := make([]string, len(removed))
+	for i, enrollment := range removed {
+		roles[i] = enrollment.Type
+	}
+	fmt.Printf("Successfully removed user %s from course %s (%s)\n", userID, courseID, strings.Join(roles, ", "))
+}
+
+func runUsersExport(courseID, outputFile string) {
+	client := newClient()
+	users, err := fetchAllUsers(client, courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching users: %v\n", err)
+		return
+	}
+
+	enrollments, err := client.GetEnrollments(courseID, 0, 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching enrollments: %v\n", err)
+		return
+	}
+
+	sections, err := client.GetSections(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching sections: %v\n", err)
+		return
+	}
+	sectionNames := make(map[int]string, len(sections))
+	for _, section := range sections {
+		sectionNames[section.ID] = section.Name
+	}
+
+	// A user can be enrolled more than once, e.g. in two sections
+	userEnrollments := make(map[int][]api.Enrollment)
+	for _, enrollment := range enrollments {
+		userEnrollments[enrollment.UserID] = append(userEnrollments[enrollment.UserID], enrollment)
+	}
+
+	out := os.Stdout
+	if outputFile != "-" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating output file: %v\n", err)
+			return
+		}
+		defer f.Close()
+		out = f
+	}
+
+	w := csv.NewWriter(out)
+
+	header := []string{
+		"ID", "Name", "Email", "LoginID", "SISUserID", "Role", "Section",
+		"CurrentGrade", "FinalGrade", "TotalActivityTime",
+	}
+	if err := w.Write(header); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing CSV: %v\n", err)
+		return
+	}
+
+	for _, user := range users {
+		var roles, sectionList []string
+		var currentGrade, finalGrade string
+		activityTime := 0
+		for _, enrollment := range userEnrollments[user.ID] {
+			if !slices.Contains(roles, enrollment.Role) {
+				roles = append(roles, enrollment.Role)
+			}
+			if name := sectionNames[enrollment.CourseSectionID]; name != "" && !slices.Contains(sectionList, name) {
+				sectionList = append(sectionList, name)
+			}
+			// Only student enrollments carry grades
+			if currentGrade == "" && finalGrade == "" {
+				currentGrade = enrollment.Grades.CurrentGrade
+				finalGrade = enrollment.Grades.FinalGrade
+			}
+			activityTime += enrollment.TotalActivityTime
+		}
+
+		record := []string{
+			strconv.Itoa(user.ID),
+			user.Name,
+			user.Email,
+			user.LoginID,
+			user.SISUserID,
+			strings.Join(roles, "; "),
+			strings.Join(sectionList, "; "),
+			currentGrade,
+			finalGrade,
+			strconv.Itoa(activityTime / 60),
+		}
+		if err := w.Write(record); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing CSV: %v\n", err)
+			return
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing CSV: %v\n", err)
+		return
+	}
+
+	if outputFile != "-" {
+		fmt.Printf("Exported %d users to %s\n", len(users), outputFile)
+	}
+}
+
+func runEnrollmentsExport(courseID, outputFile string, activeOnly, studentsOnly, includeGrades bool) {
+	client := newClient()
+	enrollments, err := client.GetEnrollments(courseID, 0, 0)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching enrollments: %v\n", err)
+		return
+	}
+
+	// Enrollments only carry the section ID and no email, so look both up
+	sections, err := client.GetSections(courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching sections: %v\n", err)
+		return
+	}
+	sectionNames := make(map[int]string, len(sections))
+	for _, section := range sections {
+		sectionNames[section.ID] = section.Name
+	}
+
+	users, err := fetchAllUsers(client, courseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching users: %v\n", err)
+		return
+	}
+	emails := make(map[int]string, len(users))
+	for _, user := range users {
+		emails[user.ID] = user.Email
+	}
+
+	out := os.Stdout
+	if outputFile != "-" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating output file: %v\n", err)
+			return
+		}
+		defer f.Close()
+		out = f
+	}
+
+	w := csv.NewWriter(out)
+
+	header := []string{
+		"User ID", "Name", "Login ID", "SIS User ID", "Email", "Role", "Enrollment State",
+		"Section", "Created At", "Last Activity At", "Total Activity Minutes",
+	}
+	if includeGrades {
+		header = append(header, "Current Grade", "Current Score", "Final Grade", "Final Score")
+	}
+	if err := w.Write(header); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing CSV: %v\n", err)
+		return
+	}
+
+	count := 0
+	for _, enrollment := range enrollments {
+		if activeOnly && enrollment.EnrollmentState != "active" {
+			continue
+		}
+		if studentsOnly && enrollment.Type != "StudentEnrollment" {
+			continue
+		}
+
+		lastActivity := ""
+		if !enrollment.LastActivityAt.IsZero() {
+			lastActivity = enrollment.LastActivityAt.Format(time.RFC3339)
+		}
+
+		record := []string{
+			strconv.Itoa(enrollment.UserID),
+			enrollment.User.Name,
+			enrollment.User.LoginID,
+			enrollment.User.SISUserID,
+			emails[enrollment.UserID],
+			enrollment.Role,
+			enrollment.EnrollmentState,
+			sectionNames[enrollment.CourseSectionID],
+			enrollment.CreatedAt.Format(time.RFC3339),
+			lastActivity,
+			strconv.Itoa(enrollment.TotalActivityTime / 60),
+		}
+		if includeGrades {
+			record = append(record,
+				enrollment.Grades.CurrentGrade,
+				strconv.FormatFloat(enrollment.Grades.CurrentScore, 'f', -1, 64),
+				enrollment.Grades.FinalGrade,
+				strconv.FormatFloat(enrollment.Grades.FinalScore, 'f', -1, 64),
+			)
+		}
+
+		if err := w.Write(record); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing CSV: %v\n", err)
+			return
+		}
+		count++
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing CSV: %v\n", err)
+		return
+	}
+
+	if outputFile != "-" {
+		fmt.Printf("Exported %d enrollments to %s\n", count, outputFile)
+	}
+}
+
+// enrollmentTypes are the enrollment types Canvas accepts
+var enrollmentTypes = []string{
+	"StudentEnrollment",
+	"TeacherEnrollment",
+	"TaEnrollment",
+	"ObserverEnrollment",
+	"DesignerEnrollment",
+}
+
+// enrollmentImportRow is one enrollment read from a bulk import CSV
+type enrollmentImportRow struct {
+	line           int
+	userID         string
+	enrollmentType string
+	sectionID      string
+	notify         bool
+}
+
+// parseEnrollmentCSV reads and validates the rows of a bulk import CSV. It
+// returns a message for every invalid row so they can all be fixed at once.
+func parseEnrollmentCSV(path string) ([]enrollmentImportRow, []string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("%s is empty", path)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"user_id", "type"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("missing required column %q", required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []enrollmentImportRow
+	var problems []string
+	for i, record := range records[1:] {
+		// Line numbers count the header
+		row := enrollmentImportRow{
+			line:           i + 2,
+			userID:         field(record, "user_id"),
+			enrollmentType: field(record, "type"),
+			sectionID:      field(record, "section_id"),
+		}
+
+		if _, err := strconv.Atoi(row.userID); err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: invalid user_id %q", row.line, row.userID))
+		}
+		if !slices.Contains(enrollmentTypes, row.enrollmentType) {
+			problems = append(problems, fmt.Sprintf("line %d: invalid type %q", row.line, row.enrollmentType))
+		}
+		if row.sectionID != "" {
+			if _, err := strconv.Atoi(row.sectionID); err != nil {
+				problems = append(problems, fmt.Sprintf("line %d: invalid section_id %q", row.line, row.sectionID))
+			}
+		}
+		if notify := field(record, "notify"); notify != "" {
+			row.notify, err = strconv.ParseBool(notify)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("line %d: invalid notify %q", row.line, notify))
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, problems, nil
+}
+
+func runEnrollmentsBulkImport(courseID, csvFile string) {
+	rows, problems, err := parseEnrollmentCSV(csvFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading CSV: %v\n", err)
+		return
+	}
+	if len(problems) > 0 {
+		fmt.Fprintf(os.Stderr, "Error: %s has %d invalid rows:\n", csvFile, len(problems))
+		for _, problem := range problems {
+			fmt.Fprintln(os.Stderr, "   "+problem)
+		}
+		return
+	}
+	if len(rows) == 0 {
+		fmt.Printf("No enrollments in %s.\n", csvFile)
+		return
+	}
+
+	if api.DryRun {
+		fmt.Printf("%s is valid: %d enrollments would be added to course %s\n", csvFile, len(rows), courseID)
+		return
+	}
+
+	labels := make([]string, len(rows))
+	for i, row := range rows {
+		labels[i] = fmt.Sprintf("user %s (%s)", row.userID, row.enrollmentType)
+	}
+
+	// Record each row's result for the summary; steps run one at a time
+	results := make([]error, len(rows))
+	ran := make([]bool, len(rows))
+
+	client := newClient()
+	title := fmt.Sprintf("Enrolling %d users in course %s", len(rows), courseID)
+	model := ui.NewProgressModel(title, labels, func(i int) error {
+		row := rows[i]
+		_, err := client.AddUserToCourse(courseID, row.userID, row.enrollmentType, row.sectionID, row.notify)
+		results[i], ran[i] = err, true
+		return err
+	})
+
+	result, err := runProgram(model)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
+		return
+	}
+
+	finalModel, ok := result.(ui.ProgressModel)
+	if !ok {
+		return
+	}
+
+	successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	failedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	skippedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+	summary := lgtable.New().
+		Border(lipgloss.NormalBorder()).
+		BorderStyle(lipgloss.NewStyle().Foreground(lipgloss.Color("240"))).
+		Headers("Line", "User ID", "Type", "Result").
+		StyleFunc(func(row, col int) lipgloss.Style {
+			return lipgloss.NewStyle().Padding(0, 1)
+		})
+	for i, row := range rows {
+		status := successStyle.Render("Enrolled")
+		switch {
+		case !ran[i]:
+			status = skippedStyle.Render("Skipped")
+		case results[i] != nil:
+			status = failedStyle.Render(results[i].Error())
+		}
+		summary.Row(strconv.Itoa(row.line), row.userID, row.enrollmentType, status)
+	}
+
+	if !finalModel.Completed {
+		fmt.Println("\nStopped before every user was enrolled.")
+	}
+	fmt.Printf("\nEnrolled %d of %d users in course %s\n\n", finalModel.Success, len(rows), courseID)
+	fmt.Println(summary.Render())
+	fmt.Printf("✅ Success: %d\n", finalModel.Success)
+	fmt.Printf("❌ Failed: %d\n", len(finalModel.Failed))
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/ui"
+	"github.com/spf13/cobra"
+)
+
+// defaultWatchInterval is how often --watch refreshes when given no interval
+const defaultWatchInterval = 30 * time.Second
+
+// addWatchFlag adds --watch/-w to cmd. The interval is optional, so -w alone
+// refreshes every defaultWatchInterval and --watch=10s sets another one.
+func addWatchFlag(cmd *cobra.Command, interval *time.Duration) {
+	cmd.Flags().DurationVarP(interval, "watch", "w", 0, "Refresh the table every interval, such as --watch=10s")
+	cmd.Flags().Lookup("watch").NoOptDefVal = defaultWatchInterval.String()
+}
+
+// watchTable makes m fetch its rows again with fetch every interval, when
+// interval is set. Refreshes skip the response cache so they see changes.
+func watchTable(m *ui.TableModel, client *api.Client, interval time.Duration, fetch ui.PageFetcher) {
+	if interval <= 0 {
+		return
+	}
+	client.Cache = nil
+	m.AutoRefreshInterval = interval
+	m.Refresh = fetch
+}
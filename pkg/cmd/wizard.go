@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/api"
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+)
+
+// NewWizardCmd creates a new command for interactive first-time setup
+func NewWizardCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "wizard",
+		Short: "Interactive first-time setup",
+		Long: `Walk through connecting Canvas CLI to your Canvas instance.
+
+The wizard asks for your Canvas URL and API token, verifies them against the
+API, and optionally installs shell completions. It runs automatically the
+first time canvas-cli is started without a config file.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			runWizard(cmd.Root())
+		},
+	}
+}
+
+// normalizeBaseURL validates a Canvas URL and makes sure it points at the API root
+func normalizeBaseURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("enter a full URL such as https://school.instructure.com")
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	if !strings.HasSuffix(u.Path, "/api/v1") {
+		u.Path += "/api/v1"
+	}
+
+	return u.String(), nil
+}
+
+func runWizard(rootCmd *cobra.Command) {
+	cfg := config.GetConfig()
+	baseURL := cfg.BaseURL
+	var apiKey string
+
+	// Step 1: Canvas URL
+	urlForm := huh.NewForm(
+		huh.NewGroup(
+			huh.NewNote().
+				Title("Welcome to Canvas CLI").
+				Description("Let's connect to your Canvas instance."),
+
+			huh.NewInput().
+				Title("Canvas URL").
+				Prompt("> ").
+				Placeholder("https://school.instructure.com").
+				Validate(func(s string) error {
+					_, err := normalizeBaseURL(s)
+					return err
+				}).
+				Value(&baseURL),
+		),
+	).WithTheme(huh.ThemeBase16())
+
+	if err := urlForm.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	baseURL, _ = normalizeBaseURL(baseURL)
+	settingsURL := strings.TrimSuffix(baseURL, "/api/v1") + "/profile/settings"
+
+	// Step 2 and 3: token instructions and token
+	tokenForm := huh.NewForm(
+		huh.NewGroup(
+			huh.NewNote().
+				Title("Generate an API token").
+				Description(fmt.Sprintf("1. Open %s\n2. Under Approved Integrations, click \"+ New Access Token\"\n3. Give it a purpose, generate it, and copy the token", settingsURL)),
+
+			huh.NewInput().
+				Title("API Token").
+				Prompt("> ").
+				EchoMode(huh.EchoModePassword).
+				Validate(func(s string) error {
+					if strings.TrimSpace(s) == "" {
+						return fmt.Errorf("token is required")
+					}
+					return nil
+				}).
+				Value(&apiKey),
+		),
+	).WithTheme(huh.ThemeBase16())
+
+	if err := tokenForm.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	// Step 4 and 5: verify the credentials
+	client := (&api.Client{
+		BaseURL:    baseURL,
+		APIKey:     strings.TrimSpace(apiKey),
+		HTTPClient: &http.Client{},
+	}).WithContext(commandContext)
+
+	fmt.Println("Verifying credentials...")
+	user, err := client.GetSelf()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Could not authenticate with Canvas: %v\n", err)
+		fmt.Fprintln(os.Stderr, "Run 'canvas-cli wizard' to try again.")
+		return
+	}
+	fmt.Printf("✅ Authenticated as %s\n\n", user.Name)
+
+	if err := config.UpdateConfig("base_url", client.BaseURL); err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving config: %v\n", err)
+		return
+	}
+	if err := config.UpdateConfig("api_key", client.APIKey); err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving config: %v\n", err)
+		return
+	}
+
+	// Step 6: shell completions
+	installCompletions := false
+	shell := filepath.Base(os.Getenv("SHELL"))
+	if err := huh.NewConfirm().
+		Title("Install shell completions?").
+		Description(fmt.Sprintf("Detected shell: %s", shell)).
+		Value(&installCompletions).
+		WithTheme(huh.ThemeBase16()).
+		Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error with form: %v\n", err)
+		return
+	}
+
+	if installCompletions {
+		path, err := installShellCompletion(rootCmd, shell)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error installing completions: %v\n", err)
+		} else {
+			fmt.Printf("Installed %s completions to %s\n", shell, path)
+		}
+	}
+
+	fmt.Println("\n✅ Canvas CLI is ready! Try 'canvas-cli courses list'.")
+}
+
+// installShellCompletion writes the completion script for shell to its
+// conventional per-user location and returns the path written
+func installShellCompletion(rootCmd *cobra.Command, shell string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	var path string
+	switch shell {
+	case "bash":
+		path = filepath.Join(home, ".local", "share", "bash-completion", "completions", rootCmd.Name())
+		err = rootCmd.GenBashCompletionV2(&buf, true)
+	case "zsh":
+		path = filepath.Join(home, ".zsh", "completions", "_"+rootCmd.Name())
+		err = rootCmd.GenZshCompletion(&buf)
+	case "fish":
+		path = filepath.Join(home, ".config", "fish", "completions", rootCmd.Name()+".fish")
+		err = rootCmd.GenFishCompletion(&buf, true)
+	default:
+		return "", fmt.Errorf("unsupported shell %q, run 'canvas-cli completion --help' instead", shell)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
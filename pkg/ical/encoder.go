@@ -0,0 +1,119 @@
+package ical
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineLength is the longest a content line may be, in bytes, before it
+// is folded onto a continuation line (RFC 5545 section 3.1)
+const maxLineLength = 75
+
+// timeLayout formats times as UTC date-times
+const timeLayout = "20060102T150405Z"
+
+// Calendar is an iCalendar file of events
+type Calendar struct {
+	ProdID string // Identifies the program that wrote the calendar
+	Name   string // Shown by calendar apps when the file is imported
+	Events []Event
+}
+
+// Event is a calendar event
+type Event struct {
+	UID         string // Globally unique ID, so re-importing updates the event
+	Summary     string
+	Description string
+	URL         string
+	Start       time.Time
+	End         time.Time
+}
+
+// Encoder writes calendars in the iCalendar format of RFC 5545
+type Encoder struct {
+	w   io.Writer
+	err error
+}
+
+// NewEncoder returns an encoder that writes to w
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes a calendar and its events, stamped with the current time
+func (e *Encoder) Encode(cal *Calendar) error {
+	stamp := time.Now().UTC().Format(timeLayout)
+
+	e.line("BEGIN", "VCALENDAR")
+	e.line("VERSION", "2.0")
+	e.line("PRODID", cal.ProdID)
+	e.line("CALSCALE", "GREGORIAN")
+	if cal.Name != "" {
+		e.line("X-WR-CALNAME", escapeText(cal.Name))
+	}
+
+	for _, event := range cal.Events {
+		e.line("BEGIN", "VEVENT")
+		e.line("UID", event.UID)
+		e.line("DTSTAMP", stamp)
+		e.line("DTSTART", event.Start.UTC().Format(timeLayout))
+		e.line("DTEND", event.End.UTC().Format(timeLayout))
+		e.line("SUMMARY", escapeText(event.Summary))
+		if event.Description != "" {
+			e.line("DESCRIPTION", escapeText(event.Description))
+		}
+		if event.URL != "" {
+			e.line("URL", event.URL)
+		}
+		e.line("END", "VEVENT")
+	}
+
+	e.line("END", "VCALENDAR")
+	return e.err
+}
+
+// line writes a content line, folded to maxLineLength bytes without
+// splitting a UTF-8 character. Lines end in CRLF.
+func (e *Encoder) line(name, value string) {
+	if e.err != nil {
+		return
+	}
+
+	s := name + ":" + value
+	var b strings.Builder
+	limit := maxLineLength
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		b.WriteString(s[:cut] + "\r\n ")
+		s = s[cut:]
+		// Continuation lines start with a space, which counts towards the limit
+		limit = maxLineLength - 1
+	}
+	b.WriteString(s + "\r\n")
+
+	_, e.err = io.WriteString(e.w, b.String())
+}
+
+// escapeText escapes a TEXT value: backslashes, semicolons, commas, and
+// newlines (RFC 5545 section 3.3.11)
+func escapeText(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	)
+	return replacer.Replace(s)
+}
+
+// UID builds an event UID from an ID that is unique within domain
+func UID(id, domain string) string {
+	return fmt.Sprintf("%s@%s", id, domain)
+}
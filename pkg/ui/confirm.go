@@ -0,0 +1,107 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ConfirmModel asks the user to confirm an action in a dialog box. It can run
+// as its own program or be embedded in another model, which forwards key
+// presses to it and handles the messages from OnConfirm and OnCancel.
+type ConfirmModel struct {
+	Message   string
+	YesLabel  string
+	NoLabel   string
+	OnConfirm tea.Cmd
+	OnCancel  tea.Cmd
+	Confirmed bool // whether the user chose YesLabel
+	Width     int  // width to center the dialog in, or 0 to not center it
+	Height    int  // height to center the dialog in, or 0 to not center it
+	yes       bool // whether YesLabel is highlighted
+}
+
+// NewConfirmModel creates a confirmation dialog with Yes and No buttons, with
+// No highlighted so that enter does not confirm by accident
+func NewConfirmModel(message string, onConfirm, onCancel tea.Cmd) ConfirmModel {
+	return ConfirmModel{
+		Message:   message,
+		YesLabel:  "Yes",
+		NoLabel:   "No",
+		OnConfirm: onConfirm,
+		OnCancel:  onCancel,
+	}
+}
+
+// Init initializes the confirm model
+func (m ConfirmModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles choosing between the buttons
+func (m ConfirmModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.Width = msg.Width
+		m.Height = msg.Height
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "y", "Y":
+			m.Confirmed = true
+			return m, m.OnConfirm
+		case "n", "N", "q", "esc", "ctrl+c":
+			return m, m.OnCancel
+		case "left", "right", "h", "l", "tab", "shift+tab":
+			m.yes = !m.yes
+		case "enter":
+			if m.yes {
+				m.Confirmed = true
+				return m, m.OnConfirm
+			}
+			return m, m.OnCancel
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the dialog box
+func (m ConfirmModel) View() string {
+	buttonStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("252")).
+		Background(lipgloss.Color("238")).
+		Padding(0, 2)
+	activeButtonStyle := buttonStyle.
+		Foreground(lipgloss.Color("229")).
+		Background(lipgloss.Color("57")).
+		Bold(true)
+
+	yes, no := buttonStyle, activeButtonStyle
+	if m.yes {
+		yes, no = activeButtonStyle, buttonStyle
+	}
+
+	buttons := lipgloss.JoinHorizontal(lipgloss.Top,
+		yes.Render(m.YesLabel),
+		"  ",
+		no.Render(m.NoLabel),
+	)
+
+	dialog := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("205")).
+		Padding(1, 2).
+		Render(lipgloss.JoinVertical(lipgloss.Center,
+			lipgloss.NewStyle().Width(50).Align(lipgloss.Center).Render(m.Message),
+			"",
+			buttons,
+		))
+
+	if m.Width > 0 {
+		dialog = lipgloss.PlaceHorizontal(m.Width, lipgloss.Center, dialog)
+	}
+	if m.Height > 0 {
+		dialog = lipgloss.PlaceVertical(m.Height, lipgloss.Center, dialog)
+	}
+	return dialog
+}
@@ -0,0 +1,376 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+)
+
+// DatePickerLayout is the format of the value of a DatePickerModel
+const DatePickerLayout = "2006-01-02 15:04"
+
+// DatePickerModel is a form field for picking a date and time on a monthly
+// calendar. It implements huh.Field, so it can be used in a huh form like the
+// built-in fields. The arrow keys move between days, pgup/pgdown between
+// months, and digits edit the time. Its value is "" when no date is picked,
+// otherwise a date in DatePickerLayout.
+type DatePickerModel struct {
+	title       string
+	description string
+	key         string
+	value       *string
+	day         time.Time // day under the cursor
+	picked      bool      // whether a date is picked, rather than left empty
+	timeInput   textinput.Model
+	focused     bool
+	err         error
+	theme       *huh.Theme
+	keymap      huh.InputKeyMap
+}
+
+// datePickerKeys are the bindings shown in the form help for the calendar
+var datePickerKeys = []key.Binding{
+	key.NewBinding(key.WithKeys("left", "right", "up", "down"), key.WithHelp("←/→/↑/↓", "day")),
+	key.NewBinding(key.WithKeys("pgup", "pgdown"), key.WithHelp("pgup/pgdn", "month")),
+	key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "today")),
+	key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "clear")),
+}
+
+// NewDatePicker creates a date picker with no date picked and the time set
+// to 23:59, the end of the day
+func NewDatePicker() *DatePickerModel {
+	timeInput := textinput.New()
+	timeInput.Prompt = "Time: "
+	timeInput.Placeholder = "HH:MM"
+	timeInput.CharLimit = 5
+	timeInput.SetValue("23:59")
+
+	value := ""
+	return &DatePickerModel{
+		value:     &value,
+		day:       today(),
+		timeInput: timeInput,
+		keymap:    huh.NewDefaultKeyMap().Input,
+	}
+}
+
+// today returns midnight at the start of the current day in the configured
+// timezone, which picked dates are entered in
+func today() time.Time {
+	now := time.Now().In(config.Location())
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Title sets the title of the date picker
+func (m *DatePickerModel) Title(title string) *DatePickerModel {
+	m.title = title
+	return m
+}
+
+// Description sets the description of the date picker
+func (m *DatePickerModel) Description(description string) *DatePickerModel {
+	m.description = description
+	return m
+}
+
+// Key sets the key of the date picker, used to look up its value in a form
+func (m *DatePickerModel) Key(key string) *DatePickerModel {
+	m.key = key
+	return m
+}
+
+// Value stores the picked date in value, and starts the picker on the date
+// value already holds, if any
+func (m *DatePickerModel) Value(value *string) *DatePickerModel {
+	m.value = value
+	if t, err := time.Parse(DatePickerLayout, *value); err == nil {
+		m.day = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		m.picked = true
+		m.timeInput.SetValue(t.Format("15:04"))
+	}
+	return m
+}
+
+// addMonths returns day moved by months, keeping its day of the month but
+// capped at the last day of the target month, so Jan 31 moves to Feb 28
+// rather than rolling over into March
+func addMonths(day time.Time, months int) time.Time {
+	first := time.Date(day.Year(), day.Month()+time.Month(months), 1, 0, 0, 0, 0, day.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(day.Day(), last)-1)
+}
+
+// pick moves the cursor to day and picks it
+func (m *DatePickerModel) pick(day time.Time) {
+	m.day = day
+	m.picked = true
+	m.store()
+}
+
+// store writes the picked date and time to the value, leaving it unchanged
+// while the time is incomplete
+func (m *DatePickerModel) store() {
+	if !m.picked {
+		*m.value = ""
+		return
+	}
+	clock, err := time.Parse("15:04", m.timeInput.Value())
+	if err != nil {
+		return
+	}
+	*m.value = m.day.Add(time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute).Format(DatePickerLayout)
+}
+
+// validate checks the time of a picked date
+func (m *DatePickerModel) validate() error {
+	if !m.picked {
+		return nil
+	}
+	if _, err := time.Parse("15:04", m.timeInput.Value()); err != nil {
+		return fmt.Errorf("time must be HH:MM, e.g. 23:59")
+	}
+	return nil
+}
+
+// Init initializes the date picker
+func (m *DatePickerModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update moves the cursor, edits the time, and moves between fields
+func (m *DatePickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	m.err = nil
+
+	switch {
+	case key.Matches(keyMsg, m.keymap.Prev):
+		return m, huh.PrevField
+	case key.Matches(keyMsg, m.keymap.Next, m.keymap.Submit):
+		if m.err = m.validate(); m.err != nil {
+			return m, nil
+		}
+		m.store()
+		return m, huh.NextField
+	}
+
+	switch keyMsg.String() {
+	case "left", "h":
+		m.pick(m.day.AddDate(0, 0, -1))
+	case "right", "l":
+		m.pick(m.day.AddDate(0, 0, 1))
+	case "up", "k":
+		m.pick(m.day.AddDate(0, 0, -7))
+	case "down", "j":
+		m.pick(m.day.AddDate(0, 0, 7))
+	case "pgup", "[":
+		m.pick(addMonths(m.day, -1))
+	case "pgdown", "]":
+		m.pick(addMonths(m.day, 1))
+	case "t":
+		m.pick(today())
+	case "x":
+		m.picked = false
+		m.store()
+	case "backspace":
+		var cmd tea.Cmd
+		m.timeInput, cmd = m.timeInput.Update(msg)
+		m.store()
+		return m, cmd
+	default:
+		// Only digits and the colon go to the time
+		if keyMsg.Type == tea.KeyRunes && strings.Trim(string(keyMsg.Runes), "0123456789:") == "" {
+			var cmd tea.Cmd
+			m.timeInput, cmd = m.timeInput.Update(msg)
+			m.store()
+			return m, cmd
+		}
+	}
+
+	return m, nil
+}
+
+// activeStyles returns the theme styles for the focus state of the picker
+func (m *DatePickerModel) activeStyles() *huh.FieldStyles {
+	theme := m.theme
+	if theme == nil {
+		theme = huh.ThemeCharm()
+	}
+	if m.focused {
+		return &theme.Focused
+	}
+	return &theme.Blurred
+}
+
+// View renders the title, the calendar, the time, and the picked date. The
+// calendar is shown even when the picker is not focused, since forms size
+// their groups from the height of each field.
+func (m *DatePickerModel) View() string {
+	styles := m.activeStyles()
+
+	var sb strings.Builder
+	title := m.title
+	if m.err != nil {
+		title += styles.ErrorIndicator.String()
+	}
+	sb.WriteString(styles.Title.Render(title) + "\n")
+	if m.description != "" {
+		sb.WriteString(styles.Description.Render(m.description) + "\n")
+	}
+
+	picked := "No date"
+	if m.picked {
+		picked = m.day.Format("Mon Jan 2, 2006")
+		if _, err := time.Parse("15:04", m.timeInput.Value()); err == nil {
+			picked += " " + m.timeInput.Value()
+		}
+	}
+
+	sb.WriteString(m.calendar(styles) + "\n")
+	sb.WriteString(m.timeInput.View() + "\n")
+	sb.WriteString(styles.TextInput.Text.Render(picked))
+
+	return styles.Base.Render(sb.String())
+}
+
+// calendar renders the month of the cursor as a grid of days starting on
+// Sunday, with the cursor highlighted. It always has six weeks so that its
+// height does not change between months.
+func (m *DatePickerModel) calendar(styles *huh.FieldStyles) string {
+	first := time.Date(m.day.Year(), m.day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	daysInMonth := first.AddDate(0, 1, -1).Day()
+
+	dayStyle := styles.Option
+	cursorStyle := styles.SelectedOption.Reverse(true)
+	if !m.picked {
+		cursorStyle = styles.Option.Underline(true)
+	}
+	todayStyle := styles.Option.Bold(true)
+	now := today()
+
+	lines := []string{
+		styles.Title.UnsetMarginTop().Render(first.Format("January 2006")),
+		styles.Description.Render("Su Mo Tu We Th Fr Sa"),
+	}
+
+	line := strings.Repeat("   ", int(first.Weekday()))
+	for day := 1; day <= daysInMonth; day++ {
+		date := first.AddDate(0, 0, day-1)
+		cell := fmt.Sprintf("%2d", day)
+		switch {
+		case date.Equal(m.day):
+			cell = cursorStyle.Render(cell)
+		case date.Equal(now):
+			cell = todayStyle.Render(cell)
+		default:
+			cell = dayStyle.Render(cell)
+		}
+		line += cell + " "
+
+		if date.Weekday() == time.Saturday || day == daysInMonth {
+			lines = append(lines, strings.TrimRight(line, " "))
+			line = ""
+		}
+	}
+
+	for len(lines) < 8 {
+		lines = append(lines, "")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Focus focuses the date picker and its time input
+func (m *DatePickerModel) Focus() tea.Cmd {
+	m.focused = true
+	return m.timeInput.Focus()
+}
+
+// Blur stores the value and checks the time
+func (m *DatePickerModel) Blur() tea.Cmd {
+	m.focused = false
+	m.timeInput.Blur()
+	m.store()
+	m.err = m.validate()
+	return nil
+}
+
+// Error returns the validation error of the date picker
+func (m *DatePickerModel) Error() error {
+	return m.err
+}
+
+// Run runs the date picker on its own
+func (m *DatePickerModel) Run() error {
+	return huh.Run(m)
+}
+
+// Skip returns false, since the date picker takes input
+func (m *DatePickerModel) Skip() bool {
+	return false
+}
+
+// Zoom returns false, since the date picker fits in its group
+func (m *DatePickerModel) Zoom() bool {
+	return false
+}
+
+// KeyBinds returns the bindings shown in the form help
+func (m *DatePickerModel) KeyBinds() []key.Binding {
+	return append(datePickerKeys, m.keymap.Prev, m.keymap.Submit, m.keymap.Next)
+}
+
+// WithTheme sets the theme, unless the picker already has one
+func (m *DatePickerModel) WithTheme(theme *huh.Theme) huh.Field {
+	if m.theme == nil {
+		m.theme = theme
+	}
+	return m
+}
+
+// WithAccessible does nothing, as the date picker has no accessible mode
+func (m *DatePickerModel) WithAccessible(bool) huh.Field {
+	return m
+}
+
+// WithKeyMap uses the input key bindings of the form to move between fields
+func (m *DatePickerModel) WithKeyMap(k *huh.KeyMap) huh.Field {
+	m.keymap = k.Input
+	return m
+}
+
+// WithWidth does nothing, as the calendar has a fixed width
+func (m *DatePickerModel) WithWidth(int) huh.Field {
+	return m
+}
+
+// WithHeight does nothing, as the calendar has a fixed height
+func (m *DatePickerModel) WithHeight(int) huh.Field {
+	return m
+}
+
+// WithPosition enables the bindings for moving to the previous and next
+// fields, or submitting on the last one
+func (m *DatePickerModel) WithPosition(p huh.FieldPosition) huh.Field {
+	m.keymap.Prev.SetEnabled(!p.IsFirst())
+	m.keymap.Next.SetEnabled(!p.IsLast())
+	m.keymap.Submit.SetEnabled(p.IsLast())
+	return m
+}
+
+// GetKey returns the key of the date picker
+func (m *DatePickerModel) GetKey() string {
+	return m.key
+}
+
+// GetValue returns the picked date, or "" when no date is picked
+func (m *DatePickerModel) GetValue() any {
+	return *m.value
+}
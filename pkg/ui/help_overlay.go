@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// HelpOverlayModel is a popup listing every keyboard shortcut of a view. The
+// view toggles it with "?" and draws it over its own content with Overlay.
+type HelpOverlayModel struct {
+	Title    string
+	Bindings map[string]string // description of each key
+	Visible  bool
+	width    int
+}
+
+// NewHelpOverlayModel creates a hidden help overlay for the given bindings
+func NewHelpOverlayModel(bindings map[string]string) HelpOverlayModel {
+	return HelpOverlayModel{
+		Title:    "Keyboard Shortcuts",
+		Bindings: bindings,
+	}
+}
+
+// Update shows and hides the overlay: "?" toggles it and esc closes it
+func (m HelpOverlayModel) Update(msg tea.Msg) (HelpOverlayModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "?":
+			m.Visible = !m.Visible
+		case "esc":
+			m.Visible = false
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the bindings in a box, sorted by key
+func (m HelpOverlayModel) View() string {
+	keys := make([]string, 0, len(m.Bindings))
+	keyWidth := 0
+	for key := range m.Bindings {
+		keys = append(keys, key)
+		keyWidth = max(keyWidth, lipgloss.Width(key))
+	}
+	sort.Strings(keys)
+
+	keyStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("170")).
+		Bold(true).
+		Width(keyWidth + 3)
+	descriptionStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+
+	lines := []string{
+		lipgloss.NewStyle().Foreground(lipgloss.Color("205")).Bold(true).Render(m.Title),
+		"",
+	}
+	for _, key := range keys {
+		lines = append(lines, keyStyle.Render(key)+descriptionStyle.Render(m.Bindings[key]))
+	}
+	lines = append(lines, "", helpStyle.UnsetMargins().Render("?/esc: Close"))
+
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("62")).
+		Padding(1, 2).
+		Render(strings.Join(lines, "\n"))
+}
+
+// Overlay draws the help box centered over background, leaving the rest of
+// background visible around it
+func (m HelpOverlayModel) Overlay(background string) string {
+	box := strings.Split(m.View(), "\n")
+	lines := strings.Split(background, "\n")
+
+	width := m.width
+	if width == 0 {
+		for _, line := range lines {
+			width = max(width, ansi.StringWidth(line))
+		}
+	}
+	boxWidth := lipgloss.Width(strings.Join(box, "\n"))
+	x := max(0, (width-boxWidth)/2)
+	y := max(0, (len(lines)-len(box))/2)
+
+	for len(lines) < y+len(box) {
+		lines = append(lines, "")
+	}
+
+	for i, boxLine := range box {
+		line := lines[y+i]
+
+		// Keep the background to the left and right of the box
+		left := ansi.Truncate(line, x, "")
+		if w := ansi.StringWidth(left); w < x {
+			left += strings.Repeat(" ", x-w)
+		}
+		right := ansi.TruncateLeft(line, x+boxWidth, "")
+
+		lines[y+i] = left + "\x1b[0m" + boxLine + right
+	}
+
+	return strings.Join(lines, "\n")
+}
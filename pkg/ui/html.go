@@ -0,0 +1,275 @@
+package ui
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+var (
+	// htmlTokenPattern matches an opening, closing, or self-closing tag
+	htmlTokenPattern = regexp.MustCompile(`<(/?)([a-zA-Z][a-zA-Z0-9]*)([^>]*)>`)
+	// htmlCommentPattern matches comments, which are dropped
+	htmlCommentPattern = regexp.MustCompile(`(?s)<!--.*?-->`)
+	// htmlSpacePattern matches runs of whitespace, which HTML shows as one space
+	htmlSpacePattern = regexp.MustCompile(`\s+`)
+	// htmlBlankLinesPattern matches runs of more than one blank line
+	htmlBlankLinesPattern = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlList is an open <ul> or <ol> element
+type htmlList struct {
+	ordered bool
+	items   int
+}
+
+// htmlRenderer converts HTML to styled terminal text one tag at a time
+type htmlRenderer struct {
+	out      strings.Builder
+	inline   strings.Builder // text of the block being built
+	width    int
+	bold     int
+	italic   int
+	code     int
+	pre      int
+	skip     int // inside <script> or <style>
+	heading  bool
+	lists    []htmlList
+	prefix   string   // bullet or number of the current list item
+	links    []string // href of each open <a>
+	linkText []int    // position in inline where each open <a> starts
+	plain    bool     // always show link URLs, for output without hyperlinks
+}
+
+// RenderHTML converts HTML such as Canvas descriptions and page bodies to
+// styled text wrapped to width: bold and italic text, bulleted and numbered
+// lists, code blocks, and links shown with Hyperlink or with their URL
+func RenderHTML(s string, width int) string {
+	return renderHTML(&htmlRenderer{width: width}, s)
+}
+
+// HTMLToText converts HTML to unstyled text without wrapping, with links
+// followed by their URL, for output read outside the terminal such as files
+func HTMLToText(s string) string {
+	return ansi.Strip(renderHTML(&htmlRenderer{plain: true}, s))
+}
+
+// renderHTML converts HTML with r one tag at a time
+func renderHTML(r *htmlRenderer, s string) string {
+	s = htmlCommentPattern.ReplaceAllString(s, "")
+
+	last := 0
+	for _, match := range htmlTokenPattern.FindAllStringSubmatchIndex(s, -1) {
+		r.text(s[last:match[0]])
+		closing := match[3] > match[2]
+		name := strings.ToLower(s[match[4]:match[5]])
+		r.tag(name, s[match[6]:match[7]], closing)
+		last = match[1]
+	}
+	r.text(s[last:])
+	r.flush()
+
+	return strings.Trim(htmlBlankLinesPattern.ReplaceAllString(r.out.String(), "\n\n"), "\n")
+}
+
+// text adds the text between two tags to the current block
+func (r *htmlRenderer) text(s string) {
+	if r.skip > 0 || s == "" {
+		return
+	}
+	s = html.UnescapeString(s)
+
+	if r.pre > 0 {
+		r.inline.WriteString(s)
+		return
+	}
+
+	s = htmlSpacePattern.ReplaceAllString(s, " ")
+	if r.inline.Len() == 0 || strings.HasSuffix(r.inline.String(), "\n") {
+		s = strings.TrimLeft(s, " ")
+	}
+	if s == "" {
+		return
+	}
+
+	style := lipgloss.NewStyle()
+	if r.bold > 0 || r.heading {
+		style = style.Bold(true)
+	}
+	if r.heading {
+		style = style.Foreground(lipgloss.Color("99"))
+	}
+	if r.italic > 0 {
+		style = style.Italic(true)
+	}
+	if r.code > 0 {
+		style = style.Foreground(lipgloss.Color("170"))
+	}
+	r.inline.WriteString(style.Render(s))
+}
+
+// tag handles an opening or closing tag
+func (r *htmlRenderer) tag(name, attrs string, closing bool) {
+	delta := 1
+	if closing {
+		delta = -1
+	}
+
+	switch name {
+	case "script", "style":
+		r.skip = max(0, r.skip+delta)
+	case "b", "strong":
+		r.bold = max(0, r.bold+delta)
+	case "i", "em":
+		r.italic = max(0, r.italic+delta)
+	case "code":
+		if r.pre == 0 {
+			r.code = max(0, r.code+delta)
+		}
+	case "br":
+		r.inline.WriteString("\n")
+	case "img":
+		if alt := htmlAttr(attrs, "alt"); alt != "" {
+			r.inline.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render("[image: " + alt + "]"))
+		}
+	case "a":
+		if !closing {
+			r.links = append(r.links, htmlAttr(attrs, "href"))
+			r.linkText = append(r.linkText, r.inline.Len())
+		} else if len(r.links) > 0 {
+			r.closeLink()
+		}
+	case "p", "div", "blockquote", "table", "tr":
+		r.flush()
+		// Separate paragraphs, except within list items
+		if (name == "p" && len(r.lists) == 0) || name == "table" {
+			r.out.WriteString("\n")
+		}
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		r.flush()
+		r.heading = !closing
+		if closing {
+			r.out.WriteString("\n")
+		}
+	case "td", "th":
+		if closing {
+			r.inline.WriteString("  ")
+		}
+	case "ul", "ol":
+		r.flush()
+		if !closing {
+			r.lists = append(r.lists, htmlList{ordered: name == "ol"})
+		} else if len(r.lists) > 0 {
+			r.lists = r.lists[:len(r.lists)-1]
+			if len(r.lists) == 0 {
+				r.out.WriteString("\n")
+			}
+		}
+	case "li":
+		r.flush()
+		if !closing && len(r.lists) > 0 {
+			list := &r.lists[len(r.lists)-1]
+			list.items++
+			indent := strings.Repeat("  ", len(r.lists)-1)
+			if list.ordered {
+				r.prefix = fmt.Sprintf("%s%d. ", indent, list.items)
+			} else {
+				r.prefix = indent + "• "
+			}
+		}
+	case "pre":
+		if !closing {
+			r.flush()
+			r.pre++
+		} else if r.pre > 0 {
+			r.pre--
+			r.flushCode()
+		}
+	}
+}
+
+// closeLink turns the text of the innermost <a> into a link, or adds its URL
+// after the text when the terminal does not support hyperlinks
+func (r *htmlRenderer) closeLink() {
+	href := r.links[len(r.links)-1]
+	start := r.linkText[len(r.linkText)-1]
+	r.links = r.links[:len(r.links)-1]
+	r.linkText = r.linkText[:len(r.linkText)-1]
+
+	if href == "" || strings.HasPrefix(href, "#") || strings.HasPrefix(href, "javascript:") {
+		return
+	}
+
+	text := r.inline.String()
+	label := text[start:]
+	linkStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("39")).Underline(true)
+
+	r.inline.Reset()
+	r.inline.WriteString(text[:start])
+	if hyperlinksEnabled && !r.plain {
+		r.inline.WriteString(Hyperlink(linkStyle.Render(label), href))
+	} else {
+		r.inline.WriteString(linkStyle.Render(label))
+		if label != href {
+			r.inline.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(" (" + href + ")"))
+		}
+	}
+}
+
+// flush wraps the current block to the width, with the list item prefix on
+// its first line and the following lines indented to match
+func (r *htmlRenderer) flush() {
+	text := strings.TrimSpace(r.inline.String())
+	r.inline.Reset()
+	if text == "" {
+		return
+	}
+	prefix := r.prefix
+	r.prefix = ""
+
+	indent := lipgloss.Width(prefix)
+	if r.width > indent {
+		text = lipgloss.NewStyle().Width(r.width - indent).Render(text)
+	}
+
+	for i, line := range strings.Split(text, "\n") {
+		if i == 0 {
+			r.out.WriteString(prefix)
+		} else {
+			r.out.WriteString(strings.Repeat(" ", indent))
+		}
+		r.out.WriteString(strings.TrimRight(line, " ") + "\n")
+	}
+}
+
+// flushCode writes the contents of a <pre> element as an indented code block
+// without rewrapping it
+func (r *htmlRenderer) flushCode() {
+	code := strings.Trim(r.inline.String(), "\n")
+	r.inline.Reset()
+	if code == "" {
+		return
+	}
+
+	codeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("252"))
+	border := lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render("│ ")
+	r.out.WriteString("\n")
+	for _, line := range strings.Split(code, "\n") {
+		r.out.WriteString(border + codeStyle.Render(line) + "\n")
+	}
+	r.out.WriteString("\n")
+}
+
+// htmlAttr returns the value of the named attribute in the attributes of a tag
+func htmlAttr(attrs, name string) string {
+	pattern := regexp.MustCompile(`(?i)\b` + name + `\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s>]+))`)
+	match := pattern.FindStringSubmatch(attrs)
+	if match == nil {
+		return ""
+	}
+	return html.UnescapeString(match[1] + match[2] + match[3])
+}
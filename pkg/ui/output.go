@@ -0,0 +1,236 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OutputFormats lists the formats accepted by NewOutputWriter
+var OutputFormats = []string{"table", "json", "csv", "yaml", "markdown", "html"}
+
+// OutputWriter writes tabular command output in a machine-readable format
+type OutputWriter interface {
+	Write(columns []string, rows [][]string) error
+}
+
+// NewOutputWriter returns a writer for the given format. The table format
+// writes plain aligned text for use when the interactive table is not wanted.
+func NewOutputWriter(format string, w io.Writer) (OutputWriter, error) {
+	switch format {
+	case "table":
+		return tableWriter{w}, nil
+	case "json":
+		return jsonWriter{w}, nil
+	case "csv":
+		return csvWriter{w}, nil
+	case "yaml":
+		return yamlWriter{w}, nil
+	case "markdown":
+		return markdownWriter{w}, nil
+	case "html":
+		return htmlWriter{w}, nil
+	}
+	return nil, fmt.Errorf("invalid output format %q (expected %s)", format, strings.Join(OutputFormats, ", "))
+}
+
+// tableWriter writes rows as plain text columns
+type tableWriter struct {
+	w io.Writer
+}
+
+func (t tableWriter) Write(columns []string, rows [][]string) error {
+	widths := make([]int, len(columns))
+	for i, column := range columns {
+		widths[i] = len(column)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if i < len(widths) && len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+
+	writeLine := func(cells []string) error {
+		padded := make([]string, len(cells))
+		for i, cell := range cells {
+			padded[i] = fmt.Sprintf("%-*s", widths[i], cell)
+		}
+		_, err := fmt.Fprintln(t.w, strings.TrimRight(strings.Join(padded, "  "), " "))
+		return err
+	}
+
+	if err := writeLine(columns); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := writeLine(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// jsonWriter writes rows as a JSON array of objects keyed by column
+type jsonWriter struct {
+	w io.Writer
+}
+
+// orderedRow marshals a row as a JSON object with keys in column order
+type orderedRow struct {
+	columns []string
+	cells   []string
+}
+
+func (r orderedRow) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, column := range r.columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(column)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(cellAt(r.cells, i))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (j jsonWriter) Write(columns []string, rows [][]string) error {
+	objects := make([]orderedRow, len(rows))
+	for i, row := range rows {
+		objects[i] = orderedRow{columns: columns, cells: row}
+	}
+
+	encoder := json.NewEncoder(j.w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(objects)
+}
+
+// csvWriter writes rows as RFC 4180 CSV with a header row
+type csvWriter struct {
+	w io.Writer
+}
+
+func (c csvWriter) Write(columns []string, rows [][]string) error {
+	writer := csv.NewWriter(c.w)
+	if err := writer.Write(columns); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
+
+// yamlWriter writes rows as a YAML sequence of mappings keyed by column
+type yamlWriter struct {
+	w io.Writer
+}
+
+func (y yamlWriter) Write(columns []string, rows [][]string) error {
+	// Build the document by hand so keys keep their column order
+	sequence := &yaml.Node{Kind: yaml.SequenceNode}
+	for _, row := range rows {
+		mapping := &yaml.Node{Kind: yaml.MappingNode}
+		for i, column := range columns {
+			mapping.Content = append(mapping.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: column},
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: cellAt(row, i)},
+			)
+		}
+		sequence.Content = append(sequence.Content, mapping)
+	}
+
+	encoder := yaml.NewEncoder(y.w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(sequence); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// markdownWriter writes rows as a GitHub-flavored Markdown table
+type markdownWriter struct {
+	w io.Writer
+}
+
+func (m markdownWriter) Write(columns []string, rows [][]string) error {
+	// Pipes and line breaks would end the cell early
+	escape := strings.NewReplacer("|", "\\|", "\r\n", " ", "\n", " ")
+
+	writeLine := func(cells []string) error {
+		escaped := make([]string, len(columns))
+		for i := range columns {
+			escaped[i] = escape.Replace(cellAt(cells, i))
+		}
+		_, err := fmt.Fprintf(m.w, "| %s |\n", strings.Join(escaped, " | "))
+		return err
+	}
+
+	if err := writeLine(columns); err != nil {
+		return err
+	}
+	separator := make([]string, len(columns))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	if err := writeLine(separator); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := writeLine(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// htmlWriter writes rows as an HTML table
+type htmlWriter struct {
+	w io.Writer
+}
+
+func (h htmlWriter) Write(columns []string, rows [][]string) error {
+	var b strings.Builder
+	b.WriteString("<table>\n  <thead>\n    <tr>")
+	for _, column := range columns {
+		b.WriteString("<th>" + html.EscapeString(column) + "</th>")
+	}
+	b.WriteString("</tr>\n  </thead>\n  <tbody>\n")
+	for _, row := range rows {
+		b.WriteString("    <tr>")
+		for i := range columns {
+			b.WriteString("<td>" + html.EscapeString(cellAt(row, i)) + "</td>")
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("  </tbody>\n</table>\n")
+
+	_, err := io.WriteString(h.w, b.String())
+	return err
+}
+
+// cellAt returns the cell at index i, or "" when the row is too short
+func cellAt(row []string, i int) string {
+	if i < len(row) {
+		return row[i]
+	}
+	return ""
+}
@@ -0,0 +1,152 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ProgressTask performs the step of a bulk operation at the given index
+type ProgressTask func(index int) error
+
+// ProgressTickMsg reports how far along a bulk operation run by another model
+// is, for a ProgressModel created with NewProgressBar
+type ProgressTickMsg struct {
+	Current int    // number of items processed
+	Total   int    // number of items in the operation
+	Label   string // the item being processed next
+}
+
+// ProgressModel shows a progress bar with item counts. Created with
+// NewProgressModel it runs a task once per item, one at a time, and counts
+// successes and failures; created with NewProgressBar it only shows the
+// progress reported by ProgressTickMsg.
+type ProgressModel struct {
+	Title     string
+	Success   int
+	Failed    []string // "label: error" for each failed item
+	Completed bool     // false if the user quit before every item ran
+	labels    []string
+	task      ProgressTask
+	current   int
+	total     int
+	label     string
+	bar       progress.Model
+}
+
+// progressStepMsg reports the result of running the task for one item
+type progressStepMsg struct {
+	index int
+	err   error
+}
+
+// NewProgressModel creates a progress model that runs task for each of the
+// items named by labels
+func NewProgressModel(title string, labels []string, task ProgressTask) ProgressModel {
+	m := NewProgressBar(title)
+	m.labels = labels
+	m.task = task
+	m.total = len(labels)
+	if len(labels) > 0 {
+		m.label = labels[0]
+	}
+	return m
+}
+
+// NewProgressBar creates a progress model that shows the progress of work
+// done elsewhere, as reported by ProgressTickMsg
+func NewProgressBar(title string) ProgressModel {
+	return ProgressModel{
+		Title: title,
+		bar: progress.New(
+			progress.WithDefaultGradient(),
+			progress.WithWidth(40),
+			progress.WithoutPercentage(),
+		),
+	}
+}
+
+// step returns a command that runs the task for the item at index
+func (m ProgressModel) step(index int) tea.Cmd {
+	return func() tea.Msg {
+		return progressStepMsg{index: index, err: m.task(index)}
+	}
+}
+
+// Init starts the first step
+func (m ProgressModel) Init() tea.Cmd {
+	if m.task == nil {
+		return nil
+	}
+	if len(m.labels) == 0 {
+		return tea.Quit
+	}
+	return m.step(0)
+}
+
+// Update records the result of each step and starts the next one
+func (m ProgressModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c", "esc":
+			return m, tea.Quit
+		}
+
+	case ProgressTickMsg:
+		m.current = msg.Current
+		m.total = msg.Total
+		m.label = msg.Label
+
+	case progressStepMsg:
+		if msg.err != nil {
+			m.Failed = append(m.Failed, fmt.Sprintf("%s: %v", m.labels[msg.index], msg.err))
+		} else {
+			m.Success++
+		}
+		m.current++
+
+		if m.current < len(m.labels) {
+			m.label = m.labels[m.current]
+			return m, m.step(m.current)
+		}
+
+		m.Completed = true
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// View renders the progress bar, counts, and the item being processed
+func (m ProgressModel) View() string {
+	if m.Completed {
+		return ""
+	}
+
+	successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	failedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+
+	percent := 0.0
+	if m.total > 0 {
+		percent = float64(m.current) / float64(m.total)
+	}
+
+	s := "\n" + m.Title + "\n\n"
+	s += m.bar.ViewAs(percent) + "\n"
+	s += fmt.Sprintf("%d/%d (%d%%)", m.current, m.total, int(percent*100))
+	if m.task != nil {
+		s += fmt.Sprintf(" • %s • %s",
+			successStyle.Render(fmt.Sprintf("%d succeeded", m.Success)),
+			failedStyle.Render(fmt.Sprintf("%d failed", len(m.Failed))))
+	}
+	s += "\n"
+
+	if m.label != "" && m.current < m.total {
+		s += "\nProcessing: " + m.label + "\n"
+	}
+
+	return s
+}
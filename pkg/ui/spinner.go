@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LoadFunc fetches the data a model needs before it can be shown
+type LoadFunc func() (interface{}, error)
+
+// LoadedFunc builds the model to show once LoadFunc has returned data
+type LoadedFunc func(data interface{}) tea.Model
+
+// SpinnerModel shows a spinner while data loads and then hands over to the
+// model built from that data
+type SpinnerModel struct {
+	Message  string
+	Err      error // the error from LoadFunc, if loading failed
+	spinner  spinner.Model
+	load     LoadFunc
+	onLoaded LoadedFunc
+	size     *tea.WindowSizeMsg // last window size, passed on to the next model
+}
+
+// spinnerLoadedMsg carries the result of LoadFunc
+type spinnerLoadedMsg struct {
+	data interface{}
+	err  error
+}
+
+// NewSpinnerModel creates a spinner model that shows message while load runs
+// and then switches to the model returned by onLoaded
+func NewSpinnerModel(message string, load LoadFunc, onLoaded LoadedFunc) SpinnerModel {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("205"))
+
+	return SpinnerModel{
+		Message:  message,
+		spinner:  s,
+		load:     load,
+		onLoaded: onLoaded,
+	}
+}
+
+// Init starts the spinner and runs LoadFunc in the background
+func (m SpinnerModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, func() tea.Msg {
+		data, err := m.load()
+		return spinnerLoadedMsg{data: data, err: err}
+	})
+}
+
+// Update animates the spinner until loading finishes
+func (m SpinnerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c", "esc":
+			return m, tea.Quit
+		}
+
+	case tea.WindowSizeMsg:
+		m.size = &msg
+
+	case spinnerLoadedMsg:
+		if msg.err != nil {
+			m.Err = msg.err
+			return m, tea.Quit
+		}
+
+		next := m.onLoaded(msg.data)
+		cmds := []tea.Cmd{next.Init()}
+
+		// The window size is only sent once, so pass it on
+		if m.size != nil {
+			var cmd tea.Cmd
+			next, cmd = next.Update(*m.size)
+			cmds = append(cmds, cmd)
+		}
+		return next, tea.Batch(cmds...)
+
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// View renders the spinner and message
+func (m SpinnerModel) View() string {
+	if m.Err != nil {
+		return ""
+	}
+	return "\n  " + m.spinner.View() + " " + m.Message + "\n"
+}
@@ -0,0 +1,47 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// colorEnabled is false once DisableColor is called
+var colorEnabled = true
+
+// DisableColor turns off colors and text attributes in every style, including
+// those of forms and other components, by rendering all styles as plain text.
+// Selected table rows are marked with a leading ">" instead.
+func DisableColor() {
+	colorEnabled = false
+	lipgloss.SetColorProfile(termenv.Ascii)
+}
+
+// ColorEnabled reports whether styles are rendered with colors
+func ColorEnabled() bool {
+	return colorEnabled
+}
+
+// markSelected replaces the padding at the start of a table row with ">"
+func markSelected(row string) string {
+	if strings.HasPrefix(row, " ") {
+		return ">" + row[1:]
+	}
+	return "> " + row
+}
+
+// DueDateStyle returns red for dates that are past due and yellow for dates
+// due within 48 hours. Later dates get the default style.
+func DueDateStyle(dueAt time.Time) lipgloss.Style {
+	now := time.Now()
+	switch {
+	case dueAt.Before(now):
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	case dueAt.Before(now.Add(48 * time.Hour)):
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("226"))
+	default:
+		return lipgloss.NewStyle()
+	}
+}
@@ -2,31 +2,162 @@ package ui
 
 import (
 	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/Reisender/canvas-cli-v2/pkg/config"
+	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
 )
 
+// PageFetcher returns the rows for the given page of results
+type PageFetcher func(page int) ([]table.Row, error)
+
 // SelectionCallback is a function called when a row is selected
 type SelectionCallback func(row table.Row)
 
 // MultiSelectionCallback is a function called with multiple selected rows
 type MultiSelectionCallback func(rows []table.Row)
 
+// RowStyleFunc returns the style to apply to a row given its raw data
+type RowStyleFunc func(row table.Row) lipgloss.Style
+
+// CellStyleFunc returns the style to apply to a cell given its row's raw data
+// and its column index in the raw data
+type CellStyleFunc func(row table.Row, column int) lipgloss.Style
+
+// CellLinkFunc returns the URL a cell links to given its row's raw data and
+// its column index in the raw data, or "" for no link
+type CellLinkFunc func(row table.Row, column int) string
+
+// hyperlinksEnabled reports whether the terminal is known to render OSC 8
+// hyperlinks; terminals without support may print the escape codes. Output
+// piped to a file or another program never gets them.
+var hyperlinksEnabled = stdoutIsTerminal() && supportsHyperlinks(os.Getenv("TERM"), os.Getenv("COLORTERM"))
+
+// stdoutIsTerminal reports whether stdout is a terminal
+func stdoutIsTerminal() bool {
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// supportsHyperlinks guesses from $TERM and $COLORTERM whether the terminal
+// renders OSC 8 hyperlinks. Modern terminals that support them advertise true
+// color or identify themselves in $TERM.
+func supportsHyperlinks(term, colorTerm string) bool {
+	if term == "" || term == "dumb" || strings.HasPrefix(term, "screen") || term == "linux" {
+		return false
+	}
+	if colorTerm == "truecolor" || colorTerm == "24bit" {
+		return true
+	}
+	for _, name := range []string{"kitty", "alacritty", "wezterm", "ghostty", "foot", "contour"} {
+		if strings.Contains(term, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Hyperlink returns label as a clickable OSC 8 link to url when the terminal
+// supports hyperlinks, and label unchanged otherwise
+func Hyperlink(label, url string) string {
+	if !hyperlinksEnabled || url == "" {
+		return label
+	}
+	return "\x1b]8;;" + url + "\x1b\\" + label + "\x1b]8;;\x1b\\"
+}
+
 // TableModel represents a table UI model
 type TableModel struct {
-	table           table.Model
-	baseRows        []table.Row    // Original rows without selection indicators
-	baseColumns     []table.Column // Original columns without selection column
-	Title           string
-	Help            string
-	OnSelect        SelectionCallback
-	OnMultiSelect   MultiSelectionCallback
+	table         table.Model
+	baseRows      []table.Row    // Original rows without selection indicators
+	baseColumns   []table.Column // Original columns without selection column
+	Title         string
+	Help          string
+	OnSelect      SelectionCallback
+	OnMultiSelect MultiSelectionCallback
+	QuitOnSelect  bool          // Whether to quit after OnSelect is called
+	ColorRowFunc  RowStyleFunc  // Optional per-row style for non-selected rows
+	ColorCellFunc CellStyleFunc // Optional per-cell style for non-selected rows
+	LinkCellFunc  CellLinkFunc  // Optional URL for each cell, shown as a hyperlink
+	VirtualScroll bool          // Only give the inner table the rows around the cursor
+
+	AutoRefreshInterval time.Duration // How often Refresh fetches the rows again, or 0 for never
+	Refresh             PageFetcher   // Fetches the rows of the current page again
+
 	selectedRows    map[int]bool
 	multiSelectMode bool
+	rowOffset       int // First visible row when rendering with ColorRowFunc
+	windowStart     int // Index among the shown rows of the inner table's first row
+	filterInput     textinput.Model
+	filterEnabled   bool  // Whether "/" starts filtering
+	filtering       bool  // Whether the filter input has focus
+	filterColumn    int   // Column matched by the filter, or -1 for all columns
+	visibleRows     []int // Indices into baseRows of the shown rows, nil when unfiltered
+	sortColumn      int   // Column baseRows are sorted by, or -1 for their original order
+	sortAscending   bool
+	rowOrder        []int // Original position of each row in baseRows, to undo sorting
+	page            int   // Current page when paging is enabled
+	fetchPage       PageFetcher
+	loadingPage     int       // Page being loaded in the background, or 0
+	pageStatus      string    // Message about the last page change, such as an error
+	lastUpdated     time.Time // When the rows were last fetched
+	refreshStatus   string    // Error from the last refresh, if it failed
+	helpOverlay     HelpOverlayModel
+	exportForm      *huh.Form // Asks for the export format after "e" is pressed
+	exportFormat    *string
+	exportStatus    string // Message about the last export
+	focusedColumn   int    // Column of the cell copied by "C", moved with left/right
+	banner          string // Brief message shown under the title, such as "Copied!"
+	bannerID        int    // Incremented for each banner so only the latest is cleared
+	termWidth       int    // Width of the terminal, 0 until the window size is known
+	termHeight      int    // Height of the terminal, 0 until the window size is known
 }
 
+// autoRefreshMsg asks for the rows to be fetched again
+type autoRefreshMsg struct{}
+
+// pageLoadedMsg carries the rows of a page loaded with n or p
+type pageLoadedMsg struct {
+	page int
+	rows []table.Row
+	err  error
+}
+
+// autoRefreshedMsg carries the rows fetched again for a page
+type autoRefreshedMsg struct {
+	page int
+	rows []table.Row
+	err  error
+}
+
+// exportStatusClearMsg hides the message about the last export
+type exportStatusClearMsg struct{}
+
+// bannerClearMsg hides the banner with the given ID
+type bannerClearMsg struct {
+	id int
+}
+
+// copiedMsg reports the result of copying text to the clipboard
+type copiedMsg struct {
+	what string
+	err  error
+}
+
+// virtualWindowSize is the number of rows given to the inner table when
+// VirtualScroll is on
+const virtualWindowSize = 100
+
 // NewTableModel creates a new table model
 func NewTableModel(t table.Model) *TableModel {
 	// Store original rows and columns
@@ -36,6 +167,11 @@ func NewTableModel(t table.Model) *TableModel {
 	baseColumns := make([]table.Column, len(t.Columns()))
 	copy(baseColumns, t.Columns())
 
+	filterInput := textinput.New()
+	filterInput.Prompt = "/"
+	filterInput.Placeholder = "filter"
+	filterInput.PromptStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("170"))
+
 	return &TableModel{
 		table:           t,
 		baseRows:        baseRows,
@@ -44,9 +180,25 @@ func NewTableModel(t table.Model) *TableModel {
 		Help:            "↑/↓: Navigate • enter: Select • q: Quit",
 		selectedRows:    make(map[int]bool),
 		multiSelectMode: false,
+		filterInput:     filterInput,
+		filterColumn:    -1,
+		helpOverlay:     NewHelpOverlayModel(nil),
+		sortColumn:      -1,
+		sortAscending:   true,
+		rowOrder:        originalOrder(len(baseRows)),
+		lastUpdated:     time.Now(),
 	}
 }
 
+// originalOrder returns the row order of n unsorted rows
+func originalOrder(n int) []int {
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	return order
+}
+
 var (
 	titleStyle = lipgloss.NewStyle().
 			Bold(true).
@@ -71,9 +223,84 @@ var (
 	noSelectionIndicator = "  "
 )
 
-// Init initializes the table model
+// DefaultTableStyles returns the table styles shared by all list views
+func DefaultTableStyles() table.Styles {
+	s := table.DefaultStyles()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(lipgloss.Color("240")).
+		BorderBottom(true).
+		Bold(true)
+	s.Selected = s.Selected.
+		Foreground(lipgloss.Color("229")).
+		Background(lipgloss.Color("57")).
+		Bold(true)
+	if !colorEnabled {
+		s.Selected = s.Selected.Transform(markSelected)
+	}
+	return s
+}
+
+// Init initializes the table model, starting the refresh timer when
+// AutoRefreshInterval is set
 func (m TableModel) Init() tea.Cmd {
-	return nil
+	return m.autoRefreshTick()
+}
+
+// autoRefreshTick waits AutoRefreshInterval before the next refresh
+func (m TableModel) autoRefreshTick() tea.Cmd {
+	if m.AutoRefreshInterval <= 0 || m.Refresh == nil {
+		return nil
+	}
+	return tea.Tick(m.AutoRefreshInterval, func(time.Time) tea.Msg {
+		return autoRefreshMsg{}
+	})
+}
+
+// autoRefresh fetches the rows of the current page again in the background
+func (m TableModel) autoRefresh() tea.Cmd {
+	page, refresh := m.page, m.Refresh
+	return func() tea.Msg {
+		rows, err := refresh(page)
+		return autoRefreshedMsg{page: page, rows: rows, err: err}
+	}
+}
+
+// applyRefresh shows the refreshed rows and schedules the next refresh
+func (m TableModel) applyRefresh(msg autoRefreshedMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.err != nil:
+		m.refreshStatus = fmt.Sprintf("Error refreshing: %v", msg.err)
+	case msg.page == m.page:
+		// Rows of a page that was left while they were fetched are dropped
+		m.refreshStatus = ""
+		m.lastUpdated = time.Now()
+		m.replaceRows(msg.rows)
+	}
+	return m, m.autoRefreshTick()
+}
+
+// replaceRows swaps in freshly fetched rows, keeping the cursor position,
+// sort, and filter. Selections follow their rows by the first column, which
+// holds an ID in most tables.
+func (m *TableModel) replaceRows(rows []table.Row) {
+	selectedKeys := make(map[string]bool, len(m.selectedRows))
+	for index := range m.selectedRows {
+		if index < len(m.baseRows) && len(m.baseRows[index]) > 0 {
+			selectedKeys[m.baseRows[index][0]] = true
+		}
+	}
+
+	m.baseRows = make([]table.Row, len(rows))
+	copy(m.baseRows, rows)
+	m.rowOrder = originalOrder(len(rows))
+	m.selectedRows = make(map[int]bool, len(selectedKeys))
+	for i, row := range m.baseRows {
+		if len(row) > 0 && selectedKeys[row[0]] {
+			m.selectedRows[i] = true
+		}
+	}
+	m.sortRows()
 }
 
 // IsRowSelected checks if a row is selected
@@ -81,9 +308,94 @@ func (m TableModel) IsRowSelected(index int) bool {
 	return m.selectedRows[index]
 }
 
+// baseIndex maps a row index in the displayed table to its index in baseRows
+func (m TableModel) baseIndex(tableIndex int) int {
+	shownIndex := m.windowStart + tableIndex
+	if m.visibleRows == nil {
+		return shownIndex
+	}
+	return m.visibleRows[shownIndex]
+}
+
+// cursorIndex returns the position of the cursor among the shown rows
+func (m TableModel) cursorIndex() int {
+	return m.windowStart + m.table.Cursor()
+}
+
+// setTableRows gives the inner table the shown rows, with selection
+// indicators in multi-select mode, and puts the cursor on the shown row at
+// cursor. With VirtualScroll only a window of rows around the cursor is given.
+func (m *TableModel) setTableRows(cursor int) {
+	shown := m.shownRows()
+	cursor = max(0, min(cursor, len(shown)-1))
+
+	start, end := 0, len(shown)
+	if m.VirtualScroll && len(shown) > virtualWindowSize {
+		start = max(0, min(cursor-virtualWindowSize/2, len(shown)-virtualWindowSize))
+		end = start + virtualWindowSize
+	}
+
+	rows := make([]table.Row, 0, end-start)
+	for _, index := range shown[start:end] {
+		row := m.baseRows[index]
+		if m.multiSelectMode {
+			// If selected, add a checkmark as the first element
+			indicator := ""
+			if m.IsRowSelected(index) {
+				indicator = "✓"
+			}
+			row = append(table.Row{indicator}, row...)
+		}
+		rows = append(rows, row)
+	}
+
+	// Keep the custom rendering on the same rows when the window moves
+	m.rowOffset -= start - m.windowStart
+	m.windowStart = start
+
+	// Move the cursor like the arrow keys do, so the inner table scrolls to it
+	m.table.SetRows(rows)
+	m.table.GotoTop()
+	m.table.MoveDown(cursor - start)
+	m.updateRowOffset()
+}
+
+// scrollWindow moves the window of rows given to the inner table when the
+// cursor gets close to either end of it
+func (m *TableModel) scrollWindow() {
+	if !m.VirtualScroll {
+		return
+	}
+
+	cursor := m.table.Cursor()
+	rows := len(m.table.Rows())
+	margin := min(m.table.Height(), virtualWindowSize/4)
+	nearTop := cursor < margin && m.windowStart > 0
+	nearBottom := rows-cursor <= margin && m.windowStart+rows < len(m.shownRows())
+	if nearTop || nearBottom {
+		m.setTableRows(m.windowStart + cursor)
+	}
+}
+
+// shownRows returns the indices into baseRows of the rows currently displayed
+func (m TableModel) shownRows() []int {
+	if m.visibleRows != nil {
+		return m.visibleRows
+	}
+	indices := make([]int, len(m.baseRows))
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices
+}
+
 // ToggleRow toggles selection status of the current row
 func (m *TableModel) ToggleRow() {
-	currentIndex := m.table.Cursor()
+	if len(m.table.Rows()) == 0 {
+		return
+	}
+
+	currentIndex := m.baseIndex(m.table.Cursor())
 	if m.selectedRows[currentIndex] {
 		delete(m.selectedRows, currentIndex)
 	} else {
@@ -99,62 +411,36 @@ func (m *TableModel) ToggleRow() {
 // updateTableWithSelectionIndicators updates the main table to show selection indicators
 func (m *TableModel) updateTableWithSelectionIndicators() {
 	// Keep track of the current cursor position
-	cursorPos := m.table.Cursor()
-
-	// Get current table dimensions
-	height := m.table.Height()
-	height = 25
-
-	// Create new rows with checkmarks
-	newRows := make([]table.Row, len(m.baseRows))
-	for i, row := range m.baseRows {
-		// If selected, add a checkmark as the first element
-		indicator := ""
-		if m.IsRowSelected(i) {
-			indicator = "✓"
-		}
-
-		// Create a new row with the selection indicator plus all original data
-		newRow := make(table.Row, len(row)+1)
-		newRow[0] = indicator
-		for j, cell := range row {
-			newRow[j+1] = cell
-		}
-		newRows[i] = newRow
-	}
+	cursorPos := m.cursorIndex()
 
 	// Create a columns slice with selection column
 	columns := []table.Column{
 		{Title: "", Width: 2},
 	}
-	columns = append(columns, m.baseColumns...)
+	columns = append(columns, m.sortedColumns()...)
 
 	// Create a new table with the updated data but preserving other settings
 	newTable := table.New(
 		table.WithColumns(columns),
-		table.WithRows(newRows),
 		table.WithFocused(true),
-		table.WithHeight(height),
+		table.WithHeight(m.multiSelectHeight()),
 	)
 
-	// Apply default styles since we can't access the existing styles directly
-	tableStyles := table.DefaultStyles()
-	tableStyles.Header = tableStyles.Header.
-		BorderStyle(lipgloss.NormalBorder()).
-		BorderForeground(lipgloss.Color("240")).
-		BorderBottom(true).
-		Bold(true)
-	tableStyles.Selected = tableStyles.Selected.
-		Foreground(lipgloss.Color("229")).
-		Background(lipgloss.Color("57")).
-		Bold(true)
-	newTable.SetStyles(tableStyles)
+	newTable.SetStyles(DefaultTableStyles())
 
-	// Set cursor to match original table
-	newTable.SetCursor(cursorPos)
-
-	// Replace the existing table
+	// Replace the existing table and add the rows with checkmarks
 	m.table = newTable
+	m.setTableRows(cursorPos)
+}
+
+// multiSelectHeight returns the height of the table in multi-select mode,
+// which fills the terminal apart from the title, help, and selection count.
+// Until the terminal size is known the table keeps its current height.
+func (m TableModel) multiSelectHeight() int {
+	if m.termHeight == 0 {
+		return m.table.Height()
+	}
+	return max(1, m.termHeight-6)
 }
 
 // GetSelectedRows returns all selected rows
@@ -170,9 +456,9 @@ func (m TableModel) GetSelectedRows() []table.Row {
 	return selected
 }
 
-// SelectAll selects all rows
+// SelectAll selects all rows shown by the current filter
 func (m *TableModel) SelectAll() {
-	for i := range m.baseRows {
+	for _, i := range m.shownRows() {
 		m.selectedRows[i] = true
 	}
 
@@ -197,27 +483,14 @@ func (m *TableModel) EnableMultiSelect() {
 	m.multiSelectMode = true
 	m.Help = "↑/↓: Navigate • space: Select/Deselect • a: Select All • enter: Perform Action on Selected • q: Quit"
 
-	// Create a fixed-height table
-	fixedHeight := 25 // Use a consistent height value
 	newTable := table.New(
 		table.WithColumns(m.table.Columns()),
 		table.WithRows(m.table.Rows()),
 		table.WithFocused(true),
-		table.WithHeight(fixedHeight),
+		table.WithHeight(m.multiSelectHeight()),
 	)
 
-	// Copy styles
-	tableStyles := table.DefaultStyles()
-	tableStyles.Header = tableStyles.Header.
-		BorderStyle(lipgloss.NormalBorder()).
-		BorderForeground(lipgloss.Color("240")).
-		BorderBottom(true).
-		Bold(true)
-	tableStyles.Selected = tableStyles.Selected.
-		Foreground(lipgloss.Color("229")).
-		Background(lipgloss.Color("57")).
-		Bold(true)
-	newTable.SetStyles(tableStyles)
+	newTable.SetStyles(DefaultTableStyles())
 
 	// Preserve cursor position
 	newTable.SetCursor(m.table.Cursor())
@@ -229,14 +502,514 @@ func (m *TableModel) EnableMultiSelect() {
 	m.updateTableWithSelectionIndicators()
 }
 
+// EnablePaging lets the user press n and p to load the next and previous
+// page of results with fetch, starting from the given page
+func (m *TableModel) EnablePaging(page int, fetch PageFetcher) {
+	m.page = page
+	m.fetchPage = fetch
+	m.Help += " • n/p: Next/Previous page"
+}
+
+// SetRows replaces the rows of the table, clearing any selections
+func (m *TableModel) SetRows(rows []table.Row) {
+	m.baseRows = make([]table.Row, len(rows))
+	copy(m.baseRows, rows)
+	m.rowOrder = originalOrder(len(rows))
+	m.selectedRows = make(map[int]bool)
+	m.table.SetCursor(0)
+	m.windowStart = 0
+	m.rowOffset = 0
+	m.sortRows()
+}
+
+// goToPage loads the given page in the background. Only one page is loaded
+// at a time.
+func (m *TableModel) goToPage(page int) tea.Cmd {
+	if m.fetchPage == nil || page < 1 || m.loadingPage != 0 {
+		return nil
+	}
+
+	m.loadingPage = page
+	m.pageStatus = fmt.Sprintf("Loading page %d...", page)
+	fetch := m.fetchPage
+	return func() tea.Msg {
+		rows, err := fetch(page)
+		return pageLoadedMsg{page: page, rows: rows, err: err}
+	}
+}
+
+// showPage shows a loaded page, staying on the current page when it is empty
+func (m *TableModel) showPage(msg pageLoadedMsg) {
+	m.loadingPage = 0
+	if msg.err != nil {
+		m.pageStatus = fmt.Sprintf("Error loading page %d: %v", msg.page, msg.err)
+		return
+	}
+	if len(msg.rows) == 0 {
+		m.pageStatus = "No more pages"
+		return
+	}
+
+	m.page = msg.page
+	m.pageStatus = ""
+	m.SetRows(msg.rows)
+}
+
+// EnableFilter lets the user press "/" to filter rows by a case-insensitive
+// substring match against the given column, or against all columns when
+// column is -1
+func (m *TableModel) EnableFilter(column int) {
+	m.filterEnabled = true
+	m.filterColumn = column
+	m.Help += " • /: Filter"
+}
+
+// rowMatches reports whether row matches the lower-cased filter query
+func (m TableModel) rowMatches(row table.Row, query string) bool {
+	if m.filterColumn >= 0 {
+		return m.filterColumn < len(row) && strings.Contains(strings.ToLower(row[m.filterColumn]), query)
+	}
+	for _, cell := range row {
+		if strings.Contains(strings.ToLower(cell), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyFilter rebuilds the displayed rows from baseRows using the filter input
+func (m *TableModel) applyFilter() {
+	query := strings.ToLower(m.filterInput.Value())
+	if query == "" {
+		m.visibleRows = nil
+	} else {
+		m.visibleRows = []int{}
+		for i, row := range m.baseRows {
+			if m.rowMatches(row, query) {
+				m.visibleRows = append(m.visibleRows, i)
+			}
+		}
+	}
+
+	if m.multiSelectMode {
+		m.updateTableWithSelectionIndicators()
+		return
+	}
+
+	m.setTableRows(m.cursorIndex())
+	m.rowOffset = 0
+	m.updateRowOffset()
+}
+
+// sortedColumns returns baseColumns with an arrow on the sort column's title
+func (m TableModel) sortedColumns() []table.Column {
+	columns := make([]table.Column, len(m.baseColumns))
+	copy(columns, m.baseColumns)
+	if m.sortColumn >= 0 && m.sortColumn < len(columns) {
+		arrow := " ▲"
+		if !m.sortAscending {
+			arrow = " ▼"
+		}
+		columns[m.sortColumn].Title += arrow
+	}
+	return columns
+}
+
+// isNumericColumn reports whether every non-empty value in the column parses
+// as a number
+func (m TableModel) isNumericColumn(column int) bool {
+	numeric := false
+	for _, row := range m.baseRows {
+		if column >= len(row) || row[column] == "" {
+			continue
+		}
+		if _, err := strconv.ParseFloat(row[column], 64); err != nil {
+			return false
+		}
+		numeric = true
+	}
+	return numeric
+}
+
+// sortRows sorts baseRows by the sort column, keeping selections with their
+// rows, and reapplies the filter
+func (m *TableModel) sortRows() {
+	numeric := m.sortColumn >= 0 && m.isNumericColumn(m.sortColumn)
+	less := func(a, b int) bool {
+		if m.sortColumn < 0 {
+			return m.rowOrder[a] < m.rowOrder[b]
+		}
+
+		var x, y string
+		if m.sortColumn < len(m.baseRows[a]) {
+			x = m.baseRows[a][m.sortColumn]
+		}
+		if m.sortColumn < len(m.baseRows[b]) {
+			y = m.baseRows[b][m.sortColumn]
+		}
+		if !m.sortAscending {
+			x, y = y, x
+		}
+
+		if numeric {
+			// Empty values parse as 0 and sort with the smallest numbers
+			xf, _ := strconv.ParseFloat(x, 64)
+			yf, _ := strconv.ParseFloat(y, 64)
+			return xf < yf
+		}
+		return strings.ToLower(x) < strings.ToLower(y)
+	}
+
+	indices := originalOrder(len(m.baseRows))
+	sort.SliceStable(indices, func(i, j int) bool {
+		return less(indices[i], indices[j])
+	})
+
+	rows := make([]table.Row, len(indices))
+	order := make([]int, len(indices))
+	selected := make(map[int]bool, len(m.selectedRows))
+	for i, index := range indices {
+		rows[i] = m.baseRows[index]
+		order[i] = m.rowOrder[index]
+		if m.selectedRows[index] {
+			selected[i] = true
+		}
+	}
+	m.baseRows = rows
+	m.rowOrder = order
+	m.selectedRows = selected
+
+	if !m.multiSelectMode {
+		m.table.SetColumns(m.sortedColumns())
+	}
+	m.applyFilter()
+}
+
+// nextSortColumn sorts by the next column, returning to the original order
+// after the last one
+func (m *TableModel) nextSortColumn() {
+	m.sortColumn++
+	if m.sortColumn >= len(m.baseColumns) {
+		m.sortColumn = -1
+	}
+	m.sortRows()
+}
+
+// reverseSort toggles between ascending and descending order
+func (m *TableModel) reverseSort() {
+	m.sortAscending = !m.sortAscending
+	if m.sortColumn >= 0 {
+		m.sortRows()
+	}
+}
+
+// keyBindings describes every key the table responds to in its current mode
+func (m TableModel) keyBindings() map[string]string {
+	bindings := map[string]string{
+		"↑/k":      "Move up",
+		"↓/j":      "Move down",
+		"pgup/b":   "Page up",
+		"pgdown/f": "Page down",
+		"home/g":   "Go to first row",
+		"end/G":    "Go to last row",
+		"s":        "Sort by the next column",
+		"S":        "Reverse the sort order",
+		"q/ctrl+c": "Quit",
+		"?":        "Show or hide this help",
+		"e":        "Export the rows to a file",
+		"c":        "Copy the row to the clipboard",
+		"C":        "Copy the focused cell to the clipboard",
+		"←/→":      "Move the focused cell",
+		"esc":      "Clear the filter, or quit",
+	}
+	if m.multiSelectMode {
+		bindings["space"] = "Select or deselect the row"
+		bindings["a"] = "Select all rows"
+		if m.OnMultiSelect != nil {
+			bindings["enter"] = "Act on the selected rows"
+		}
+	} else if m.OnSelect != nil {
+		bindings["enter"] = "Open the row"
+	}
+	if m.filterEnabled {
+		bindings["/"] = "Filter rows"
+	}
+	if m.fetchPage != nil {
+		bindings["n"] = "Next page of results"
+		bindings["p"] = "Previous page of results"
+	}
+	return bindings
+}
+
+// exportRows writes baseRows and baseColumns in the given output format
+func (m TableModel) exportRows(format string) string {
+	titles := make([]string, len(m.baseColumns))
+	for i, column := range m.baseColumns {
+		titles[i] = column.Title
+	}
+	rows := make([][]string, len(m.baseRows))
+	for i, row := range m.baseRows {
+		rows[i] = row
+	}
+
+	var b strings.Builder
+	writer, _ := NewOutputWriter(format, &b)
+	writer.Write(titles, rows) // Writing to a strings.Builder cannot fail
+	return b.String()
+}
+
+// ToMarkdown returns all rows of the table as a Markdown table
+func (m TableModel) ToMarkdown() string {
+	return m.exportRows("markdown")
+}
+
+// ToHTML returns all rows of the table as an HTML table
+func (m TableModel) ToHTML() string {
+	return m.exportRows("html")
+}
+
+// ToCSV returns all rows of the table as CSV
+func (m TableModel) ToCSV() string {
+	return m.exportRows("csv")
+}
+
+// export writes all rows to export-<timestamp>.<ext> in the current
+// directory, where ext is "md", "html", or "csv"
+func (m *TableModel) export(ext string) {
+	var content string
+	switch ext {
+	case "md":
+		content = m.ToMarkdown()
+	case "html":
+		content = m.ToHTML()
+	default:
+		content = m.ToCSV()
+	}
+
+	filename := fmt.Sprintf("export-%s.%s", time.Now().Format("20060102-150405"), ext)
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		m.exportStatus = fmt.Sprintf("Error exporting rows: %v", err)
+		return
+	}
+	m.exportStatus = fmt.Sprintf("✅ Exported %d rows to %s", len(m.baseRows), filename)
+}
+
+// copyToClipboard returns a command that copies text to the system clipboard
+func copyToClipboard(text, what string) tea.Cmd {
+	return func() tea.Msg {
+		return copiedMsg{what: what, err: clipboard.WriteAll(text)}
+	}
+}
+
+// currentRow returns the raw data of the row under the cursor
+func (m TableModel) currentRow() (table.Row, bool) {
+	if len(m.table.Rows()) == 0 {
+		return nil, false
+	}
+	return m.baseRows[m.baseIndex(m.table.Cursor())], true
+}
+
+// focusedColumnTitle returns the title of the focused column without any sort
+// arrow
+func (m TableModel) focusedColumnTitle() string {
+	if m.focusedColumn >= len(m.baseColumns) {
+		return ""
+	}
+	return m.baseColumns[m.focusedColumn].Title
+}
+
+// showBanner shows message under the title for a couple of seconds
+func (m *TableModel) showBanner(message string) tea.Cmd {
+	m.banner = message
+	m.bannerID++
+	id := m.bannerID
+	return tea.Tick(2*time.Second, func(time.Time) tea.Msg {
+		return bannerClearMsg{id: id}
+	})
+}
+
+// updateExportForm handles messages while the export format form is open
+func (m TableModel) updateExportForm(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok && key.String() == "esc" {
+		m.exportForm = nil
+		return m, nil
+	}
+
+	form, cmd := m.exportForm.Update(msg)
+	m.exportForm = form.(*huh.Form)
+
+	switch m.exportForm.State {
+	case huh.StateCompleted:
+		m.export(*m.exportFormat)
+		m.exportForm = nil
+		return m, tea.Tick(3*time.Second, func(time.Time) tea.Msg {
+			return exportStatusClearMsg{}
+		})
+	case huh.StateAborted:
+		m.exportForm = nil
+		return m, nil
+	}
+	return m, cmd
+}
+
+// updateFilter handles key presses while the filter input has focus
+func (m TableModel) updateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+	case "esc":
+		// Clear the filter and restore all rows
+		m.filtering = false
+		m.filterInput.Blur()
+		m.filterInput.SetValue("")
+		m.applyFilter()
+		return m, nil
+	case "enter":
+		// Keep the filter but return to the table
+		m.filtering = false
+		m.filterInput.Blur()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.filterInput, cmd = m.filterInput.Update(msg)
+	m.applyFilter()
+	return m, cmd
+}
+
 // Update updates the table model
 func (m TableModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Keep loading pages and refreshing while the export form is open
+	switch msg := msg.(type) {
+	case pageLoadedMsg:
+		m.showPage(msg)
+		return m, nil
+	case autoRefreshMsg:
+		return m, m.autoRefresh()
+	case autoRefreshedMsg:
+		return m.applyRefresh(msg)
+	}
+
+	if m.exportForm != nil {
+		return m.updateExportForm(msg)
+	}
+
 	var cmd tea.Cmd
 	switch msg := msg.(type) {
+	case exportStatusClearMsg:
+		m.exportStatus = ""
+		return m, nil
+
+	case bannerClearMsg:
+		if msg.id == m.bannerID {
+			m.banner = ""
+		}
+		return m, nil
+
+	case copiedMsg:
+		if msg.err != nil {
+			return m, m.showBanner(fmt.Sprintf("Error copying to clipboard: %v", msg.err))
+		}
+		return m, m.showBanner(fmt.Sprintf("📋 Copied %s!", msg.what))
+
+	case tea.WindowSizeMsg:
+		m.helpOverlay, _ = m.helpOverlay.Update(msg)
+		m.termWidth, m.termHeight = msg.Width, msg.Height
+
+		// Rebuild the table at the new size with the selections still shown
+		if m.multiSelectMode {
+			m.updateTableWithSelectionIndicators()
+		}
+
+		// VirtualScroll is set after the table is filled, so window the rows
+		// on the first message, which is always the window size
+		if m.VirtualScroll && len(m.table.Rows()) > virtualWindowSize {
+			m.setTableRows(m.cursorIndex())
+		}
+
 	case tea.KeyMsg:
+		if m.filtering {
+			return m.updateFilter(msg)
+		}
+
+		// While the help is shown, only closing it or quitting does anything
+		if m.helpOverlay.Visible {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m.helpOverlay, cmd = m.helpOverlay.Update(msg)
+			return m, cmd
+		}
+
 		switch msg.String() {
-		case "q", "ctrl+c", "esc":
+		case "e":
+			m.exportFormat = new(string)
+			m.exportForm = huh.NewForm(
+				huh.NewGroup(
+					huh.NewSelect[string]().
+						Title("Export as").
+						Options(
+							huh.NewOption("Markdown", "md"),
+							huh.NewOption("HTML", "html"),
+							huh.NewOption("CSV", "csv"),
+						).
+						Value(m.exportFormat),
+				),
+			).WithTheme(huh.ThemeBase16()).WithShowHelp(false)
+			return m, m.exportForm.Init()
+		case "c":
+			if row, ok := m.currentRow(); ok {
+				return m, copyToClipboard(strings.Join(row, "\t"), "row")
+			}
+			return m, nil
+		case "C":
+			if row, ok := m.currentRow(); ok && m.focusedColumn < len(row) {
+				return m, copyToClipboard(row[m.focusedColumn], m.focusedColumnTitle())
+			}
+			return m, nil
+		case "left", "right":
+			if len(m.baseColumns) == 0 {
+				return m, nil
+			}
+			if msg.String() == "left" {
+				m.focusedColumn = max(0, m.focusedColumn-1)
+			} else {
+				m.focusedColumn = min(len(m.baseColumns)-1, m.focusedColumn+1)
+			}
+			return m, m.showBanner(fmt.Sprintf("Column: %s • C: Copy cell", m.focusedColumnTitle()))
+		case "?":
+			m.helpOverlay.Bindings = m.keyBindings()
+			m.helpOverlay, cmd = m.helpOverlay.Update(msg)
+			return m, cmd
+		case "/":
+			if m.filterEnabled {
+				m.filtering = true
+				return m, m.filterInput.Focus()
+			}
+		case "esc":
+			// Clear an active filter before quitting
+			if m.filterInput.Value() != "" {
+				m.filterInput.SetValue("")
+				m.applyFilter()
+				return m, nil
+			}
 			return m, tea.Quit
+		case "q", "ctrl+c":
+			return m, tea.Quit
+		case "s":
+			m.nextSortColumn()
+			return m, nil
+		case "S":
+			m.reverseSort()
+			return m, nil
+		case "n":
+			if m.fetchPage != nil {
+				return m, m.goToPage(m.page + 1)
+			}
+		case "p":
+			if m.fetchPage != nil {
+				return m, m.goToPage(m.page - 1)
+			}
 		case " ":
 			if m.multiSelectMode {
 				m.ToggleRow()
@@ -256,21 +1029,133 @@ func (m TableModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				selectedRow := m.table.SelectedRow()
 				// For single selection, return the raw selected row
 				m.OnSelect(selectedRow)
+				if m.QuitOnSelect {
+					return m, tea.Quit
+				}
 			}
 			return m, nil
 		}
 	}
 
+	// The inner table only knows about the window of rows it was given
+	if key, ok := msg.(tea.KeyMsg); ok && m.VirtualScroll {
+		switch key.String() {
+		case "home", "g":
+			m.setTableRows(0)
+			return m, nil
+		case "end", "G":
+			m.setTableRows(len(m.shownRows()) - 1)
+			return m, nil
+		}
+	}
+
 	// Update the main table
 	m.table, cmd = m.table.Update(msg)
+	m.scrollWindow()
+	m.updateRowOffset()
 
 	return m, cmd
 }
 
+// updateRowOffset scrolls the custom row rendering so the cursor stays visible
+func (m *TableModel) updateRowOffset() {
+	cursor := m.table.Cursor()
+	height := m.table.Height()
+
+	if cursor < m.rowOffset {
+		m.rowOffset = cursor
+	} else if height > 0 && cursor >= m.rowOffset+height {
+		m.rowOffset = cursor - height + 1
+	}
+	if m.rowOffset < 0 {
+		m.rowOffset = 0
+	}
+}
+
+// renderTable renders the table, applying ColorRowFunc and ColorCellFunc to
+// non-selected rows and LinkCellFunc to all rows. The inner table has no
+// per-row styling, so when one of these is set the header and visible rows
+// are rendered here instead.
+func (m TableModel) renderTable() string {
+	if m.ColorRowFunc == nil && m.ColorCellFunc == nil && (m.LinkCellFunc == nil || !hyperlinksEnabled) {
+		return m.table.View()
+	}
+
+	styles := DefaultTableStyles()
+	columns := m.table.Columns()
+	rows := m.table.Rows()
+	cursor := m.table.Cursor()
+	height := m.table.Height()
+
+	// Render the header
+	headers := make([]string, 0, len(columns))
+	for _, col := range columns {
+		if col.Width <= 0 {
+			continue
+		}
+		style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).Inline(true)
+		headers = append(headers, styles.Header.Render(style.Render(runewidth.Truncate(col.Title, col.Width, "…"))))
+	}
+
+	lines := []string{lipgloss.JoinHorizontal(lipgloss.Top, headers...)}
+
+	// Render the visible rows
+	end := m.rowOffset + height
+	if end > len(rows) {
+		end = len(rows)
+	}
+	for r := m.rowOffset; r < end; r++ {
+		// Pass the raw row data without selection indicators
+		baseRow := m.baseRows[m.baseIndex(r)]
+
+		cells := make([]string, 0, len(columns))
+		for i, value := range rows[r] {
+			if i >= len(columns) || columns[i].Width <= 0 {
+				continue
+			}
+			// The selection indicator column is not part of the raw data
+			column := i
+			if m.multiSelectMode {
+				column--
+			}
+
+			text := runewidth.Truncate(value, columns[i].Width, "…")
+			if m.LinkCellFunc != nil && column >= 0 {
+				text = Hyperlink(text, m.LinkCellFunc(baseRow, column))
+			}
+			style := lipgloss.NewStyle().Width(columns[i].Width).MaxWidth(columns[i].Width).Inline(true)
+			cell := style.Render(text)
+			if r != cursor && m.ColorCellFunc != nil && column >= 0 {
+				cell = m.ColorCellFunc(baseRow, column).Render(cell)
+			}
+			cells = append(cells, styles.Cell.Render(cell))
+		}
+		line := lipgloss.JoinHorizontal(lipgloss.Top, cells...)
+
+		if r == cursor {
+			line = styles.Selected.Render(line)
+		} else if m.ColorRowFunc != nil {
+			line = m.ColorRowFunc(baseRow).Render(line)
+		}
+		lines = append(lines, line)
+	}
+
+	// Pad to a constant height like the inner table's viewport
+	for i := end - m.rowOffset; i < height; i++ {
+		lines = append(lines, "")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
 // View renders the table model
 func (m TableModel) View() string {
 	result := titleStyle.Render(m.Title) + "\n\n"
 
+	if m.banner != "" {
+		result += lipgloss.NewStyle().Foreground(lipgloss.Color("42")).MarginLeft(2).Render(m.banner) + "\n\n"
+	}
+
 	if m.multiSelectMode {
 		// For multi-selection mode, show selection count
 		if len(m.selectedRows) > 0 {
@@ -279,11 +1164,43 @@ func (m TableModel) View() string {
 		}
 
 		// The table already has selection indicators from updateTableWithSelectionIndicators
-		result += m.table.View() + "\n\n"
+		result += m.renderTable() + "\n\n"
 	} else {
-		result += m.table.View() + "\n\n"
+		result += m.renderTable() + "\n\n"
+	}
+
+	if m.filtering {
+		result += "  " + m.filterInput.View() + "\n\n"
+	} else if query := m.filterInput.Value(); query != "" {
+		// Show a confirmed filter in the status bar
+		result += helpStyle.Render(fmt.Sprintf("Filter: %q • %d of %d rows • esc: Clear filter",
+			query, len(m.shownRows()), len(m.baseRows))) + "\n"
 	}
 
-	result += helpStyle.Render(m.Help)
+	if m.exportForm != nil {
+		result += lipgloss.NewStyle().MarginLeft(2).Render(m.exportForm.View()) + "\n"
+	} else if m.exportStatus != "" {
+		result += helpStyle.Render(m.exportStatus) + "\n"
+	}
+
+	if m.pageStatus != "" {
+		result += helpStyle.Render(m.pageStatus) + "\n"
+	} else if m.page > 1 {
+		result += helpStyle.Render(fmt.Sprintf("Page %d — press n for next, p for previous", m.page)) + "\n"
+	}
+
+	if m.AutoRefreshInterval > 0 && m.Refresh != nil {
+		status := fmt.Sprintf("Last updated: %s • refreshing every %s", m.lastUpdated.In(config.Location()).Format("15:04:05"), m.AutoRefreshInterval)
+		if m.refreshStatus != "" {
+			status += " • " + m.refreshStatus
+		}
+		result += helpStyle.Render(status) + "\n"
+	}
+
+	result += helpStyle.Render(m.Help + " • s/S: Sort/Reverse • e: Export • ?: Help")
+
+	if m.helpOverlay.Visible {
+		return m.helpOverlay.Overlay(result)
+	}
 	return result
 }
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ContentRenderer renders the content of a ViewportModel for the given width
+type ContentRenderer func(width int) string
+
+// ViewportModel shows scrollable content in a bordered viewport
+type ViewportModel struct {
+	Title    string
+	Help     string
+	render   ContentRenderer
+	viewport viewport.Model
+	ready    bool
+}
+
+// NewViewportModel creates a viewport model whose content is rendered by
+// render whenever the terminal is resized
+func NewViewportModel(title string, render ContentRenderer) ViewportModel {
+	return ViewportModel{
+		Title:  title,
+		Help:   "↑/↓: Scroll • q/esc: Quit",
+		render: render,
+	}
+}
+
+// Init initializes the viewport model
+func (m ViewportModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles scrolling, resizing and quitting
+func (m ViewportModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "q", "ctrl+c":
+			return m, tea.Quit
+		}
+
+	case tea.WindowSizeMsg:
+		if !m.ready {
+			m.viewport = viewport.New(msg.Width, msg.Height-4) // leave room for header/footer
+			m.viewport.Style = lipgloss.NewStyle().
+				BorderStyle(lipgloss.RoundedBorder()).
+				BorderForeground(lipgloss.Color("62")).
+				PaddingRight(2)
+			m.ready = true
+		} else {
+			m.viewport.Width = msg.Width
+			m.viewport.Height = msg.Height - 4
+		}
+		m.viewport.SetContent(m.render(msg.Width))
+	}
+
+	if !m.ready {
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the viewport with its title and help
+func (m ViewportModel) View() string {
+	if !m.ready {
+		return "Loading..."
+	}
+
+	headerStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("205")).
+		Bold(true).
+		MarginBottom(1).
+		PaddingLeft(2)
+
+	footerStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		PaddingTop(1).
+		PaddingLeft(2)
+
+	return headerStyle.Render(m.Title) + "\n" +
+		m.viewport.View() + "\n" +
+		footerStyle.Render(m.Help)
+}